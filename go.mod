@@ -2,4 +2,8 @@ module salesforce-mcp-server
 
 go 1.25.3
 
-require github.com/google/uuid v1.6.0 // indirect
+require (
+	github.com/google/uuid v1.6.0
+	golang.org/x/text v0.40.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,396 @@
+// Package config loads the server configuration from a JSON or YAML file
+// and SF_* environment variables, validates it, and builds the
+// configuration of the infrastructure components from it.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"salesforce-mcp-server/internal/infrastructure/salesforce"
+)
+
+// ============================================================================
+// Config - Server Configuration
+// ============================================================================
+
+// Config is the complete server configuration. Fields tagged env can be
+// overridden by that environment variable; fields tagged secret are
+// redacted whenever the configuration is printed or logged.
+type Config struct {
+	Salesforce     SalesforceConfig     `json:"salesforce" yaml:"salesforce"`
+	Server         ServerConfig         `json:"server" yaml:"server"`
+	TLS            TLSConfig            `json:"tls" yaml:"tls"`
+	TokenStore     TokenStoreConfig     `json:"token_store" yaml:"token_store"`
+	Cache          CacheConfig          `json:"cache" yaml:"cache"`
+	CircuitBreaker CircuitBreakerConfig `json:"circuit_breaker" yaml:"circuit_breaker"`
+	Metrics        MetricsConfig        `json:"metrics" yaml:"metrics"`
+	Tracing        TracingConfig        `json:"tracing" yaml:"tracing"`
+	Logging        LoggingConfig        `json:"logging" yaml:"logging"`
+}
+
+// SalesforceConfig holds the connected app and REST client settings.
+type SalesforceConfig struct {
+	InstanceURL    string   `json:"instance_url" yaml:"instance_url" env:"SF_INSTANCE_URL"`
+	ClientID       string   `json:"client_id" yaml:"client_id" env:"SF_CLIENT_ID"`
+	ClientSecret   string   `json:"client_secret" yaml:"client_secret" env:"SF_CLIENT_SECRET" secret:"true"`
+	RedirectURL    string   `json:"redirect_url" yaml:"redirect_url" env:"SF_REDIRECT_URL"`
+	IsSandbox      bool     `json:"is_sandbox" yaml:"is_sandbox" env:"SF_IS_SANDBOX"`
+	APIVersion     string   `json:"api_version" yaml:"api_version" env:"SF_API_VERSION"`
+	Timeout        Duration `json:"timeout" yaml:"timeout" env:"SF_TIMEOUT"`
+	MaxRetries     int      `json:"max_retries" yaml:"max_retries" env:"SF_MAX_RETRIES"`
+	RetryBaseDelay Duration `json:"retry_base_delay" yaml:"retry_base_delay" env:"SF_RETRY_BASE_DELAY"`
+}
+
+// ServerConfig selects the MCP transport.
+type ServerConfig struct {
+	Transport       string   `json:"transport" yaml:"transport" env:"SF_SERVER_TRANSPORT"` // "stdio" or "http"
+	Addr            string   `json:"addr" yaml:"addr" env:"SF_SERVER_ADDR"`                // Listen address for http
+	ReadTimeout     Duration `json:"read_timeout" yaml:"read_timeout"`
+	WriteTimeout    Duration `json:"write_timeout" yaml:"write_timeout"`
+	ShutdownTimeout Duration `json:"shutdown_timeout" yaml:"shutdown_timeout"`
+}
+
+// TLSConfig enables TLS for the http transport.
+type TLSConfig struct {
+	Enabled  bool   `json:"enabled" yaml:"enabled" env:"SF_TLS_ENABLED"`
+	CertFile string `json:"cert_file" yaml:"cert_file" env:"SF_TLS_CERT_FILE"`
+	KeyFile  string `json:"key_file" yaml:"key_file" env:"SF_TLS_KEY_FILE"`
+}
+
+// TokenStoreConfig selects where OAuth tokens are kept.
+type TokenStoreConfig struct {
+	Type          string `json:"type" yaml:"type" env:"SF_TOKEN_STORE_TYPE"` // "memory" or "file"
+	Path          string `json:"path" yaml:"path" env:"SF_TOKEN_STORE_PATH"`
+	EncryptionKey string `json:"encryption_key" yaml:"encryption_key" env:"SF_TOKEN_ENCRYPTION_KEY" secret:"true"`
+}
+
+// CacheConfig configures the read-through Nippou cache.
+type CacheConfig struct {
+	Enabled    bool     `json:"enabled" yaml:"enabled" env:"SF_CACHE_ENABLED"`
+	TTL        Duration `json:"ttl" yaml:"ttl" env:"SF_CACHE_TTL"`
+	MaxEntries int      `json:"max_entries" yaml:"max_entries"`
+}
+
+// CircuitBreakerConfig configures how Salesforce failures open the circuit.
+type CircuitBreakerConfig struct {
+	Enabled          bool     `json:"enabled" yaml:"enabled"`
+	FailureThreshold int      `json:"failure_threshold" yaml:"failure_threshold"` // Consecutive failures that open the circuit
+	OpenTimeout      Duration `json:"open_timeout" yaml:"open_timeout"`           // Time before a trial request is let through
+}
+
+// MetricsConfig configures the Prometheus endpoint.
+type MetricsConfig struct {
+	Enabled bool   `json:"enabled" yaml:"enabled" env:"SF_METRICS_ENABLED"`
+	Path    string `json:"path" yaml:"path"`
+}
+
+// TracingConfig configures trace export.
+type TracingConfig struct {
+	Enabled     bool    `json:"enabled" yaml:"enabled" env:"SF_TRACING_ENABLED"`
+	Endpoint    string  `json:"endpoint" yaml:"endpoint" env:"SF_TRACING_ENDPOINT"`
+	ServiceName string  `json:"service_name" yaml:"service_name"`
+	SampleRate  float64 `json:"sample_rate" yaml:"sample_rate"` // Fraction of traces kept, 0 to 1
+}
+
+// LoggingConfig configures the slog output.
+type LoggingConfig struct {
+	Level  string `json:"level" yaml:"level" env:"SF_LOG_LEVEL"`    // debug, info, warn or error
+	Format string `json:"format" yaml:"format" env:"SF_LOG_FORMAT"` // "json" or "text"
+}
+
+// Default returns the configuration used for everything a file or the
+// environment does not set. The client settings match
+// salesforce.DefaultConfig.
+func Default() *Config {
+	client := salesforce.DefaultConfig("")
+	return &Config{
+		Salesforce: SalesforceConfig{
+			APIVersion:     client.APIVersion,
+			Timeout:        Duration(client.Timeout),
+			MaxRetries:     client.MaxRetries,
+			RetryBaseDelay: Duration(client.RetryBaseDelay),
+		},
+		Server: ServerConfig{
+			Transport:       TransportStdio,
+			Addr:            ":8080",
+			ReadTimeout:     Duration(30 * time.Second),
+			WriteTimeout:    Duration(30 * time.Second),
+			ShutdownTimeout: Duration(10 * time.Second),
+		},
+		TokenStore: TokenStoreConfig{Type: TokenStoreMemory},
+		Cache: CacheConfig{
+			Enabled:    true,
+			TTL:        Duration(5 * time.Minute),
+			MaxEntries: 1000,
+		},
+		CircuitBreaker: CircuitBreakerConfig{
+			Enabled:          true,
+			FailureThreshold: 5,
+			OpenTimeout:      Duration(30 * time.Second),
+		},
+		Metrics: MetricsConfig{Path: "/metrics"},
+		Tracing: TracingConfig{ServiceName: "salesforce-mcp-server", SampleRate: 0.1},
+		Logging: LoggingConfig{Level: "info", Format: "json"},
+	}
+}
+
+// Allowed values of the string settings.
+const (
+	TransportStdio   = "stdio"
+	TransportHTTP    = "http"
+	TokenStoreMemory = "memory"
+	TokenStoreFile   = "file"
+)
+
+// MinAPIVersion is the oldest Salesforce REST API version the client supports.
+const MinAPIVersion = 55.0
+
+// Login hosts for production orgs and sandboxes.
+const (
+	productionLoginURL = "https://login.salesforce.com"
+	sandboxLoginURL    = "https://test.salesforce.com"
+)
+
+// LoginURL returns the OAuth host for the org type.
+func (c *SalesforceConfig) LoginURL() string {
+	if c.IsSandbox {
+		return sandboxLoginURL
+	}
+	return productionLoginURL
+}
+
+// ClientConfig builds the REST client configuration.
+func (c *Config) ClientConfig() *salesforce.ClientConfig {
+	return &salesforce.ClientConfig{
+		BaseURL:        strings.TrimSuffix(c.Salesforce.InstanceURL, "/"),
+		APIVersion:     c.Salesforce.APIVersion,
+		Timeout:        time.Duration(c.Salesforce.Timeout),
+		MaxRetries:     c.Salesforce.MaxRetries,
+		RetryBaseDelay: time.Duration(c.Salesforce.RetryBaseDelay),
+	}
+}
+
+// ============================================================================
+// Validation
+// ============================================================================
+
+// FieldError is one invalid setting. Field is the path in the file, such as
+// "salesforce.api_version".
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors lists every invalid setting, so a configuration can be
+// fixed in one pass.
+type ValidationErrors []*FieldError
+
+func (v ValidationErrors) Error() string {
+	parts := make([]string, len(v))
+	for i, e := range v {
+		parts[i] = e.Error()
+	}
+	return "invalid configuration: " + strings.Join(parts, "; ")
+}
+
+// add records an invalid setting.
+func (v *ValidationErrors) add(field, format string, args ...interface{}) {
+	*v = append(*v, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// positive records an error unless d is greater than zero.
+func (v *ValidationErrors) positive(field string, d Duration) {
+	if d <= 0 {
+		v.add(field, "must be positive")
+	}
+}
+
+// Validate checks required settings and ranges. It returns ValidationErrors
+// listing every problem, or nil.
+func (c *Config) Validate() error {
+	var errs ValidationErrors
+
+	sf := &c.Salesforce
+	if sf.InstanceURL == "" {
+		errs.add("salesforce.instance_url", "is required")
+	} else if u, err := url.Parse(sf.InstanceURL); err != nil || u.Scheme != "https" || u.Host == "" {
+		errs.add("salesforce.instance_url", "must be an https URL")
+	}
+	if sf.ClientID == "" {
+		errs.add("salesforce.client_id", "is required")
+	}
+	if sf.RedirectURL != "" {
+		if u, err := url.Parse(sf.RedirectURL); err != nil || !u.IsAbs() {
+			errs.add("salesforce.redirect_url", "must be an absolute URL")
+		}
+	}
+	if version, err := parseAPIVersion(sf.APIVersion); err != nil {
+		errs.add("salesforce.api_version", "%v", err)
+	} else if version < MinAPIVersion {
+		errs.add("salesforce.api_version", "must be at least v%.1f", MinAPIVersion)
+	}
+	errs.positive("salesforce.timeout", sf.Timeout)
+	if sf.MaxRetries < 0 {
+		errs.add("salesforce.max_retries", "must not be negative")
+	}
+	errs.positive("salesforce.retry_base_delay", sf.RetryBaseDelay)
+
+	switch c.Server.Transport {
+	case TransportStdio:
+	case TransportHTTP:
+		if c.Server.Addr == "" {
+			errs.add("server.addr", "is required for the http transport")
+		}
+		errs.positive("server.read_timeout", c.Server.ReadTimeout)
+		errs.positive("server.write_timeout", c.Server.WriteTimeout)
+		errs.positive("server.shutdown_timeout", c.Server.ShutdownTimeout)
+	default:
+		errs.add("server.transport", "must be %q or %q", TransportStdio, TransportHTTP)
+	}
+
+	if c.TLS.Enabled {
+		if c.TLS.CertFile == "" {
+			errs.add("tls.cert_file", "is required when TLS is enabled")
+		}
+		if c.TLS.KeyFile == "" {
+			errs.add("tls.key_file", "is required when TLS is enabled")
+		}
+	}
+
+	switch c.TokenStore.Type {
+	case TokenStoreMemory:
+	case TokenStoreFile:
+		if c.TokenStore.Path == "" {
+			errs.add("token_store.path", "is required for the file store")
+		}
+	default:
+		errs.add("token_store.type", "must be %q or %q", TokenStoreMemory, TokenStoreFile)
+	}
+
+	if c.Cache.Enabled {
+		errs.positive("cache.ttl", c.Cache.TTL)
+		if c.Cache.MaxEntries <= 0 {
+			errs.add("cache.max_entries", "must be positive")
+		}
+	}
+
+	if c.CircuitBreaker.Enabled {
+		if c.CircuitBreaker.FailureThreshold <= 0 {
+			errs.add("circuit_breaker.failure_threshold", "must be positive")
+		}
+		errs.positive("circuit_breaker.open_timeout", c.CircuitBreaker.OpenTimeout)
+	}
+
+	if c.Metrics.Enabled && !strings.HasPrefix(c.Metrics.Path, "/") {
+		errs.add("metrics.path", "must start with /")
+	}
+
+	if c.Tracing.Enabled && c.Tracing.Endpoint == "" {
+		errs.add("tracing.endpoint", "is required when tracing is enabled")
+	}
+	if c.Tracing.SampleRate < 0 || c.Tracing.SampleRate > 1 {
+		errs.add("tracing.sample_rate", "must be between 0 and 1")
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Logging.Level)); err != nil {
+		errs.add("logging.level", "must be debug, info, warn or error")
+	}
+	if c.Logging.Format != "json" && c.Logging.Format != "text" {
+		errs.add("logging.format", `must be "json" or "text"`)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// parseAPIVersion parses a version such as "v59.0".
+func parseAPIVersion(s string) (float64, error) {
+	number, ok := strings.CutPrefix(s, "v")
+	if !ok {
+		return 0, fmt.Errorf("must look like v59.0, got %q", s)
+	}
+	version, err := strconv.ParseFloat(number, 64)
+	if err != nil || !strings.Contains(number, ".") {
+		return 0, fmt.Errorf("must look like v59.0, got %q", s)
+	}
+	return version, nil
+}
+
+// ============================================================================
+// Printing - Secrets Redacted
+// ============================================================================
+
+// redacted replaces a secret that is set. Unset secrets stay empty so the
+// output still shows what is missing.
+const redacted = "[REDACTED]"
+
+// Redacted returns a copy of c with every secret replaced.
+func (c *Config) Redacted() *Config {
+	clone := *c
+	redact(reflect.ValueOf(&clone).Elem())
+	return &clone
+}
+
+// redact clears the secret string fields of the struct v.
+func redact(v reflect.Value) {
+	for i := 0; i < v.NumField(); i++ {
+		field, value := v.Type().Field(i), v.Field(i)
+		switch {
+		case value.Kind() == reflect.Struct:
+			redact(value)
+		case field.Tag.Get("secret") == "true" && value.Kind() == reflect.String && value.String() != "":
+			value.SetString(redacted)
+		}
+	}
+}
+
+// String returns the configuration as indented JSON with secrets redacted.
+func (c *Config) String() string {
+	data, err := json.MarshalIndent(c.Redacted(), "", "  ")
+	if err != nil {
+		return fmt.Sprintf("config: %v", err)
+	}
+	return string(data)
+}
+
+// LogValue implements slog.LogValuer so logging a Config never leaks secrets.
+func (c *Config) LogValue() slog.Value {
+	return slog.StringValue(c.String())
+}
+
+// ============================================================================
+// Duration - Human-readable Durations
+// ============================================================================
+
+// Duration is a time.Duration written as a string such as "30s" or "5m" in
+// files and environment variables.
+type Duration time.Duration
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: use a value such as 30s or 5m", text)
+	}
+	*d = Duration(parsed)
+	return nil
+}
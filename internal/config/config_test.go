@@ -0,0 +1,299 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// envOf returns a lookup over a fixed environment.
+func envOf(vars map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	}
+}
+
+// writeFile writes a config file into a temporary directory.
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+// requiredEnv sets the settings without defaults.
+var requiredEnv = map[string]string{
+	"SF_INSTANCE_URL": "https://example.my.salesforce.com",
+	"SF_CLIENT_ID":    "client-id",
+}
+
+// validConfig returns a configuration that passes Validate.
+func validConfig() *Config {
+	c := Default()
+	c.Salesforce.InstanceURL = "https://example.my.salesforce.com"
+	c.Salesforce.ClientID = "client-id"
+	return c
+}
+
+// ============================================================================
+// Loading
+// ============================================================================
+
+func TestLoad_YAMLWithEnvOverlay(t *testing.T) {
+	path := writeFile(t, "config.yaml", `
+salesforce:
+  instance_url: https://file.my.salesforce.com/
+  client_id: from-file
+  client_secret: file-secret
+  api_version: v58.0
+  timeout: 10s
+server:
+  transport: http
+  addr: ":9000"
+cache:
+  ttl: 2m
+`)
+	config, err := NewLoader().WithLookupEnv(envOf(map[string]string{
+		"SF_CLIENT_ID":   "from-env",
+		"SF_IS_SANDBOX":  "true",
+		"SF_MAX_RETRIES": "5",
+	})).Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	sf := config.Salesforce
+	if sf.ClientID != "from-env" {
+		t.Errorf("ClientID = %q, want the environment to win", sf.ClientID)
+	}
+	if sf.ClientSecret != "file-secret" || sf.APIVersion != "v58.0" || !sf.IsSandbox {
+		t.Errorf("Salesforce = %+v", sf)
+	}
+	if config.Server.Addr != ":9000" || time.Duration(config.Cache.TTL) != 2*time.Minute {
+		t.Errorf("Server.Addr = %q, Cache.TTL = %v", config.Server.Addr, config.Cache.TTL)
+	}
+	if config.Logging.Level != "info" {
+		t.Errorf("Logging.Level = %q, want the default", config.Logging.Level)
+	}
+	if got := sf.LoginURL(); got != "https://test.salesforce.com" {
+		t.Errorf("LoginURL() = %q, want the sandbox host", got)
+	}
+
+	client := config.ClientConfig()
+	if client.BaseURL != "https://file.my.salesforce.com" || client.APIVersion != "v58.0" ||
+		client.Timeout != 10*time.Second || client.MaxRetries != 5 || client.RetryBaseDelay != 500*time.Millisecond {
+		t.Errorf("ClientConfig() = %+v", client)
+	}
+}
+
+func TestLoad_JSON(t *testing.T) {
+	path := writeFile(t, "config.json", `{
+		"salesforce": {"instance_url": "https://example.my.salesforce.com", "client_id": "id", "retry_base_delay": "1s"},
+		"logging": {"level": "debug", "format": "text"}
+	}`)
+	config, err := NewLoader().WithLookupEnv(envOf(nil)).Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if time.Duration(config.Salesforce.RetryBaseDelay) != time.Second || config.Logging.Format != "text" {
+		t.Errorf("config = %+v", config)
+	}
+}
+
+func TestLoad_EnvOnly(t *testing.T) {
+	config, err := NewLoader().WithLookupEnv(envOf(requiredEnv)).Load("")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if config.Salesforce.APIVersion != "v59.0" || config.Server.Transport != TransportStdio {
+		t.Errorf("config = %+v", config)
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		env     map[string]string
+		want    string
+	}{
+		{
+			name:    "unknown YAML key",
+			file:    "config.yaml",
+			content: "salesforce:\n  clientid: typo\n",
+			env:     requiredEnv,
+			want:    "clientid",
+		},
+		{
+			name:    "unknown JSON key",
+			file:    "config.json",
+			content: `{"server": {"port": 80}}`,
+			env:     requiredEnv,
+			want:    "port",
+		},
+		{
+			name:    "malformed duration",
+			file:    "config.yaml",
+			content: "salesforce:\n  timeout: thirty\n",
+			env:     requiredEnv,
+			want:    "invalid duration",
+		},
+		{
+			name: "malformed environment values",
+			env:  map[string]string{"SF_IS_SANDBOX": "maybe", "SF_TIMEOUT": "5"},
+			want: "SF_IS_SANDBOX: invalid boolean",
+		},
+		{
+			name: "missing required settings",
+			env:  map[string]string{},
+			want: "salesforce.instance_url: is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := ""
+			if tt.file != "" {
+				path = writeFile(t, tt.file, tt.content)
+			}
+			_, err := NewLoader().WithLookupEnv(envOf(tt.env)).Load(path)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Load() error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoad_UnsupportedFormat(t *testing.T) {
+	path := writeFile(t, "config.toml", "")
+	if _, err := NewLoader().Load(path); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("Load() error = %v, want ErrUnsupportedFormat", err)
+	}
+}
+
+func TestLoad_EmptyYAML(t *testing.T) {
+	path := writeFile(t, "config.yml", "\n")
+	if _, err := NewLoader().WithLookupEnv(envOf(requiredEnv)).Load(path); err != nil {
+		t.Errorf("Load() error = %v, want defaults", err)
+	}
+}
+
+// ============================================================================
+// Validation
+// ============================================================================
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		fields []string
+	}{
+		{"valid", func(c *Config) {}, nil},
+		{"old API version", func(c *Config) { c.Salesforce.APIVersion = "v54.0" }, []string{"salesforce.api_version"}},
+		{"minimum API version", func(c *Config) { c.Salesforce.APIVersion = "v55.0" }, nil},
+		{"malformed API version", func(c *Config) { c.Salesforce.APIVersion = "59" }, []string{"salesforce.api_version"}},
+		{"http instance URL", func(c *Config) { c.Salesforce.InstanceURL = "http://example.com" }, []string{"salesforce.instance_url"}},
+		{
+			name: "timeouts and retries",
+			modify: func(c *Config) {
+				c.Salesforce.Timeout = 0
+				c.Salesforce.MaxRetries = -1
+				c.Salesforce.RetryBaseDelay = Duration(-time.Second)
+			},
+			fields: []string{"salesforce.timeout", "salesforce.max_retries", "salesforce.retry_base_delay"},
+		},
+		{
+			name: "http transport",
+			modify: func(c *Config) {
+				c.Server.Transport = TransportHTTP
+				c.Server.Addr = ""
+				c.Server.ReadTimeout = 0
+			},
+			fields: []string{"server.addr", "server.read_timeout"},
+		},
+		{"unknown transport", func(c *Config) { c.Server.Transport = "grpc" }, []string{"server.transport"}},
+		{"TLS without files", func(c *Config) { c.TLS.Enabled = true }, []string{"tls.cert_file", "tls.key_file"}},
+		{"file store without path", func(c *Config) { c.TokenStore.Type = TokenStoreFile }, []string{"token_store.path"}},
+		{"cache bounds", func(c *Config) { c.Cache.MaxEntries = 0 }, []string{"cache.max_entries"}},
+		{"disabled cache is not checked", func(c *Config) { c.Cache = CacheConfig{} }, nil},
+		{"breaker threshold", func(c *Config) { c.CircuitBreaker.FailureThreshold = 0 }, []string{"circuit_breaker.failure_threshold"}},
+		{"sample rate", func(c *Config) { c.Tracing.SampleRate = 1.5 }, []string{"tracing.sample_rate"}},
+		{"tracing endpoint", func(c *Config) { c.Tracing.Enabled = true }, []string{"tracing.endpoint"}},
+		{"metrics path", func(c *Config) { c.Metrics = MetricsConfig{Enabled: true, Path: "metrics"} }, []string{"metrics.path"}},
+		{
+			name: "logging",
+			modify: func(c *Config) {
+				c.Logging.Level = "verbose"
+				c.Logging.Format = "xml"
+			},
+			fields: []string{"logging.level", "logging.format"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validConfig()
+			tt.modify(c)
+			err := c.Validate()
+			if tt.fields == nil {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var errs ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("Validate() error = %v, want ValidationErrors", err)
+			}
+			if len(errs) != len(tt.fields) {
+				t.Fatalf("Validate() = %v, want fields %v", errs, tt.fields)
+			}
+			for i, e := range errs {
+				if e.Field != tt.fields[i] {
+					t.Errorf("errs[%d].Field = %q, want %q", i, e.Field, tt.fields[i])
+				}
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Redaction
+// ============================================================================
+
+func TestString_RedactsSecrets(t *testing.T) {
+	c := validConfig()
+	c.Salesforce.ClientSecret = "s3cret"
+	c.TokenStore.EncryptionKey = "k3y"
+
+	out := c.String()
+	if strings.Contains(out, "s3cret") || strings.Contains(out, "k3y") {
+		t.Errorf("String() leaks a secret:\n%s", out)
+	}
+	if !strings.Contains(out, `"client_secret": "[REDACTED]"`) || !strings.Contains(out, `"timeout": "30s"`) {
+		t.Errorf("String() =\n%s", out)
+	}
+	if c.Salesforce.ClientSecret != "s3cret" {
+		t.Error("String() must not modify the configuration")
+	}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("loaded", "config", c)
+	if strings.Contains(buf.String(), "s3cret") {
+		t.Errorf("log output leaks a secret: %s", buf.String())
+	}
+}
+
+func TestString_KeepsUnsetSecretsEmpty(t *testing.T) {
+	if out := validConfig().String(); !strings.Contains(out, `"client_secret": ""`) {
+		t.Errorf("String() should show an unset secret as empty:\n%s", out)
+	}
+}
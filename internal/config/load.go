@@ -0,0 +1,157 @@
+package config
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrUnsupportedFormat is returned for files that are not .json, .yaml or .yml.
+var ErrUnsupportedFormat = errors.New("config: unsupported file format")
+
+// ============================================================================
+// Loader - File, then Environment
+// ============================================================================
+
+// Loader builds a Config from the defaults, an optional file and the
+// environment, in that order, and validates the result.
+type Loader struct {
+	lookupEnv func(string) (string, bool)
+}
+
+// NewLoader creates a loader that reads the process environment.
+func NewLoader() *Loader {
+	return &Loader{lookupEnv: os.LookupEnv}
+}
+
+// WithLookupEnv sets the environment lookup (useful for testing).
+func (l *Loader) WithLookupEnv(fn func(string) (string, bool)) *Loader {
+	if fn != nil {
+		l.lookupEnv = fn
+	}
+	return l
+}
+
+// Load reads the file at path, when path is not empty, and overlays the
+// SF_* environment variables. Unknown keys in the file are errors, so typos
+// do not silently fall back to defaults.
+func (l *Loader) Load(path string) (*Config, error) {
+	config := Default()
+	if path != "" {
+		if err := decodeFile(path, config); err != nil {
+			return nil, err
+		}
+	}
+	if err := l.applyEnv(reflect.ValueOf(config).Elem()); err != nil {
+		return nil, err
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Load loads the configuration with the process environment.
+func Load(path string) (*Config, error) {
+	return NewLoader().Load(path)
+}
+
+// decodeFile decodes a JSON or YAML file over config, chosen by extension.
+func decodeFile(path string, config *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(config)
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		err = dec.Decode(config)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, path)
+	}
+	// An empty YAML file decodes to io.EOF; it simply sets nothing.
+	if err != nil && !(errors.Is(err, io.EOF) && len(bytes.TrimSpace(data)) == 0) {
+		return fmt.Errorf("config: parse %s: %w", path, err)
+	}
+	return nil
+}
+
+// applyEnv sets every field of the struct v whose env variable is set.
+// Malformed values are collected and reported together.
+func (l *Loader) applyEnv(v reflect.Value) error {
+	var errs ValidationErrors
+	l.walkEnv(v, &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (l *Loader) walkEnv(v reflect.Value, errs *ValidationErrors) {
+	for i := 0; i < v.NumField(); i++ {
+		field, value := v.Type().Field(i), v.Field(i)
+		if value.Kind() == reflect.Struct {
+			l.walkEnv(value, errs)
+			continue
+		}
+		name := field.Tag.Get("env")
+		if name == "" {
+			continue
+		}
+		raw, ok := l.lookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := setValue(value, strings.TrimSpace(raw)); err != nil {
+			errs.add(name, "%v", err)
+		}
+	}
+}
+
+// setValue parses s into the field value.
+func setValue(value reflect.Value, s string) error {
+	if u, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", s)
+		}
+		value.SetBool(b)
+	case reflect.Int:
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", s)
+		}
+		value.SetInt(int64(n))
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", s)
+		}
+		value.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type %s", value.Type())
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Calendar Errors
+// ============================================================================
+
+// HolidayError reports an invalid holiday definition.
+type HolidayError struct {
+	Date    string
+	Message string
+}
+
+func (e *HolidayError) Error() string {
+	return fmt.Sprintf("invalid holiday %q: %s", e.Date, e.Message)
+}
+
+// ============================================================================
+// Holiday - Named Non-Business Date
+// ============================================================================
+
+// dateLayout is the canonical YYYY-MM-DD form used for holiday keys.
+const dateLayout = "2006-01-02"
+
+// Holiday is a named non-business date.
+type Holiday struct {
+	Date time.Time
+	Name string
+}
+
+// ParseHoliday creates a Holiday from a YYYY-MM-DD date, as found in config.
+func ParseHoliday(date, name string) (Holiday, error) {
+	t, err := time.Parse(dateLayout, strings.TrimSpace(date))
+	if err != nil {
+		return Holiday{}, &HolidayError{Date: date, Message: "expected YYYY-MM-DD format"}
+	}
+	return Holiday{Date: t, Name: strings.TrimSpace(name)}, nil
+}
+
+// JapaneseHolidays returns the bundled national holidays in date order.
+func JapaneseHolidays() []Holiday {
+	holidays := make([]Holiday, 0, len(japaneseHolidays))
+	for date, name := range japaneseHolidays {
+		t, _ := time.Parse(dateLayout, date)
+		holidays = append(holidays, Holiday{Date: t, Name: name})
+	}
+	sort.Slice(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+	return holidays
+}
+
+// ============================================================================
+// BusinessCalendar - Business Day Rules
+// ============================================================================
+
+// Config holds configuration for BusinessCalendar.
+type Config struct {
+	Weekend                 []time.Weekday // Non-business weekdays
+	CompanyHolidays         []Holiday      // Company-specific closures
+	ExcludeNationalHolidays bool           // Ignore the bundled Japanese holiday table
+}
+
+// DefaultConfig returns a Saturday/Sunday weekend with Japanese national holidays.
+func DefaultConfig() *Config {
+	return &Config{
+		Weekend: []time.Weekday{time.Saturday, time.Sunday},
+	}
+}
+
+// BusinessCalendar decides which dates are business days. Only the calendar
+// date of a time.Time is considered; its clock and location are ignored.
+// BusinessCalendar is immutable and safe for concurrent use.
+type BusinessCalendar struct {
+	weekend  map[time.Weekday]bool
+	holidays map[string]string
+}
+
+// NewBusinessCalendar creates a BusinessCalendar. A nil config uses DefaultConfig.
+// Company holidays take precedence over national holidays on the same date.
+func NewBusinessCalendar(config *Config) *BusinessCalendar {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	c := &BusinessCalendar{
+		weekend:  make(map[time.Weekday]bool, len(config.Weekend)),
+		holidays: make(map[string]string),
+	}
+	for _, day := range config.Weekend {
+		c.weekend[day] = true
+	}
+	if !config.ExcludeNationalHolidays {
+		for date, name := range japaneseHolidays {
+			c.holidays[date] = name
+		}
+	}
+	for _, h := range config.CompanyHolidays {
+		c.holidays[h.Date.Format(dateLayout)] = h.Name
+	}
+	return c
+}
+
+// IsBusinessDay reports whether t is neither a weekend day nor a holiday.
+func (c *BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	if c.weekend[t.Weekday()] {
+		return false
+	}
+	_, holiday := c.holidays[t.Format(dateLayout)]
+	return !holiday
+}
+
+// HolidayName returns the name of the holiday on t, if any.
+func (c *BusinessCalendar) HolidayName(t time.Time) (string, bool) {
+	name, ok := c.holidays[t.Format(dateLayout)]
+	return name, ok
+}
+
+// BusinessDays returns the business days from start to end inclusive, as
+// midnight UTC dates.
+func (c *BusinessCalendar) BusinessDays(start, end time.Time) []time.Time {
+	var days []time.Time
+	for day := DateOf(start); !day.After(DateOf(end)); day = day.AddDate(0, 0, 1) {
+		if c.IsBusinessDay(day) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// DateOf truncates t to midnight UTC of its calendar date.
+func DateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,130 @@
+package calendar
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// ============================================================================
+// Bundled Holiday Table Tests
+// ============================================================================
+
+func TestJapaneseHolidays_Table(t *testing.T) {
+	holidays := JapaneseHolidays()
+	if len(holidays) != len(japaneseHolidays) {
+		t.Fatalf("JapaneseHolidays() returned %d entries, want %d", len(holidays), len(japaneseHolidays))
+	}
+	for i, h := range holidays {
+		if y := h.Date.Year(); y < JapaneseHolidaysFirstYear || y > JapaneseHolidaysLastYear {
+			t.Errorf("%s is outside the declared coverage", h.Date.Format(dateLayout))
+		}
+		if i > 0 && !holidays[i-1].Date.Before(h.Date) {
+			t.Errorf("holidays not in date order at %s", h.Date.Format(dateLayout))
+		}
+		// A substitute holiday is only declared after a Sunday holiday run
+		if h.Name == "振替休日" && h.Date.Weekday() == time.Sunday {
+			t.Errorf("substitute holiday %s falls on a Sunday", h.Date.Format(dateLayout))
+		}
+	}
+}
+
+func TestJapaneseHolidays_EveryYearHasNewYearsDay(t *testing.T) {
+	for y := JapaneseHolidaysFirstYear; y <= JapaneseHolidaysLastYear; y++ {
+		if name := japaneseHolidays[time.Date(y, 1, 1, 0, 0, 0, 0, time.UTC).Format(dateLayout)]; name != "元日" {
+			t.Errorf("%d-01-01 = %q, want 元日", y, name)
+		}
+	}
+}
+
+// ============================================================================
+// BusinessCalendar Tests
+// ============================================================================
+
+func TestBusinessCalendar_IsBusinessDay(t *testing.T) {
+	cal := NewBusinessCalendar(nil)
+	tests := []struct {
+		date     string
+		expected bool
+	}{
+		{"2024-01-15", true},  // Monday
+		{"2024-01-13", false}, // Saturday
+		{"2024-01-14", false}, // Sunday
+		{"2024-01-08", false}, // 成人の日
+		{"2024-05-06", false}, // 振替休日
+		{"2026-09-22", false}, // 国民の休日
+		{"2030-01-01", true},  // Outside the table: not treated as a holiday
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			if got := cal.IsBusinessDay(date(tt.date)); got != tt.expected {
+				t.Errorf("IsBusinessDay(%s) = %v, want %v", tt.date, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBusinessCalendar_CompanyHolidays(t *testing.T) {
+	founding, _ := ParseHoliday("2024-06-03", "創立記念日")
+	override, _ := ParseHoliday("2024-01-08", "全社休業日")
+	cal := NewBusinessCalendar(&Config{
+		Weekend:         []time.Weekday{time.Sunday},
+		CompanyHolidays: []Holiday{founding, override},
+	})
+
+	if cal.IsBusinessDay(date("2024-06-03")) {
+		t.Error("company holiday should not be a business day")
+	}
+	if !cal.IsBusinessDay(date("2024-01-13")) {
+		t.Error("Saturday should be a business day when only Sunday is the weekend")
+	}
+	if name, _ := cal.HolidayName(date("2024-01-08")); name != "全社休業日" {
+		t.Errorf("HolidayName() = %q, want company holiday to take precedence", name)
+	}
+}
+
+func TestBusinessCalendar_ExcludeNationalHolidays(t *testing.T) {
+	cal := NewBusinessCalendar(&Config{
+		Weekend:                 []time.Weekday{time.Saturday, time.Sunday},
+		ExcludeNationalHolidays: true,
+	})
+	if !cal.IsBusinessDay(date("2024-01-08")) {
+		t.Error("national holiday should be a business day when excluded")
+	}
+}
+
+func TestBusinessCalendar_BusinessDays(t *testing.T) {
+	cal := NewBusinessCalendar(nil)
+	// Golden Week 2024: 4/29 (Mon) and 5/3-5/6 are holidays
+	days := cal.BusinessDays(date("2024-04-27"), time.Date(2024, 5, 7, 18, 30, 0, 0, time.FixedZone("JST", 9*3600)))
+
+	var got []string
+	for _, d := range days {
+		got = append(got, d.Format(dateLayout))
+	}
+	want := []string{"2024-04-30", "2024-05-01", "2024-05-02", "2024-05-07"}
+	if len(got) != len(want) {
+		t.Fatalf("BusinessDays() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("BusinessDays()[%d] = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
+
+func TestParseHoliday_Invalid(t *testing.T) {
+	_, err := ParseHoliday("2024/06/03", "x")
+	var holidayErr *HolidayError
+	if !errors.As(err, &holidayErr) {
+		t.Errorf("ParseHoliday() error = %v, want HolidayError", err)
+	}
+}
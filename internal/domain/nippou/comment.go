@@ -0,0 +1,163 @@
+package nippou
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// ============================================================================
+// Comment Entity - Feedback Thread on a Nippou
+// ============================================================================
+
+const (
+	// MaxCommentLength is the maximum allowed length for a comment body.
+	MaxCommentLength = 4000
+	// MaxAuthorLength is the maximum allowed length for a comment author.
+	MaxAuthorLength = 100
+)
+
+// Comment errors.
+var (
+	ErrEmptyComment     = &DomainError{Code: ErrCodeValidation, Field: "body", Message: "comment cannot be empty"}
+	ErrCommentTooLong   = &DomainError{Code: ErrCodeLimitExceeded, Field: "body", Message: "comment exceeds maximum length"}
+	ErrEmptyAuthor      = &DomainError{Code: ErrCodeValidation, Field: "author", Message: "author cannot be empty"}
+	ErrAuthorTooLong    = &DomainError{Code: ErrCodeLimitExceeded, Field: "author", Message: "author exceeds maximum length"}
+	ErrMissingNippouRef = &DomainError{Code: ErrCodeValidation, Field: "nippouId", Message: "comment must belong to a nippou"}
+)
+
+// Comment is a reply to a Nippou, typically advice from a manager.
+// Comments are immutable once posted; they can only be deleted.
+type Comment struct {
+	id        ID
+	nippouID  ID
+	author    string
+	body      string
+	createdAt time.Time
+}
+
+// NewComment creates a validated Comment on the Nippou identified by nippouID.
+// The author is normalized as single-line text and the body as multiline
+// text, the same way Nippou content is.
+func NewComment(nippouID ID, author, body string) (*Comment, error) {
+	return newComment(defaultIDGenerator, time.Now, nippouID, author, body)
+}
+
+// newComment creates a Comment with an injectable ID generator and clock.
+func newComment(gen IDGenerator, now func() time.Time, nippouID ID, author, body string) (*Comment, error) {
+	if nippouID.IsEmpty() {
+		return nil, ErrMissingNippouRef
+	}
+
+	author, err := SingleLineText.Normalize("author", author)
+	if err != nil {
+		return nil, err
+	}
+	if author == "" {
+		return nil, ErrEmptyAuthor
+	}
+	if utf8.RuneCountInString(author) > MaxAuthorLength {
+		return nil, ErrAuthorTooLong
+	}
+
+	body, err = MultilineText.Normalize("body", body)
+	if err != nil {
+		return nil, err
+	}
+	if body == "" {
+		return nil, ErrEmptyComment
+	}
+	if utf8.RuneCountInString(body) > MaxCommentLength {
+		return nil, ErrCommentTooLong
+	}
+
+	return &Comment{
+		id:        gen.Generate(),
+		nippouID:  nippouID,
+		author:    author,
+		body:      body,
+		createdAt: now(),
+	}, nil
+}
+
+// ID returns the comment's unique identifier.
+func (c *Comment) ID() ID {
+	if c == nil {
+		return ID{}
+	}
+	return c.id
+}
+
+// NippouID returns the ID of the Nippou the comment belongs to.
+func (c *Comment) NippouID() ID {
+	if c == nil {
+		return ID{}
+	}
+	return c.nippouID
+}
+
+// Author returns the name of the person who posted the comment.
+func (c *Comment) Author() string {
+	if c == nil {
+		return ""
+	}
+	return c.author
+}
+
+// Body returns the comment text.
+func (c *Comment) Body() string {
+	if c == nil {
+		return ""
+	}
+	return c.body
+}
+
+// CreatedAt returns when the comment was posted.
+func (c *Comment) CreatedAt() time.Time {
+	if c == nil {
+		return time.Time{}
+	}
+	return c.createdAt
+}
+
+// ============================================================================
+// Comment Repository Interface
+// ============================================================================
+
+// CommentRepository defines persistence for comments.
+type CommentRepository interface {
+	// FindCommentByID returns nil if the comment does not exist.
+	FindCommentByID(id ID) (*Comment, error)
+	// FindByNippouID returns the comments on a Nippou, oldest first.
+	FindByNippouID(nippouID ID) ([]*Comment, error)
+	SaveComment(c *Comment) error
+	DeleteComment(id ID) error
+}
+
+// ReconstructedComment contains all fields needed to reconstruct a Comment from storage.
+type ReconstructedComment struct {
+	ID        string
+	NippouID  string
+	Author    string
+	Body      string
+	CreatedAt time.Time
+}
+
+// ReconstructComment creates a Comment from stored data without validation.
+// This should only be used by repository implementations.
+func ReconstructComment(data ReconstructedComment) (*Comment, error) {
+	id, err := IDFromString(data.ID)
+	if err != nil {
+		return nil, err
+	}
+	nippouID, err := IDFromString(data.NippouID)
+	if err != nil {
+		return nil, err
+	}
+	return &Comment{
+		id:        id,
+		nippouID:  nippouID,
+		author:    data.Author,
+		body:      data.Body,
+		createdAt: data.CreatedAt,
+	}, nil
+}
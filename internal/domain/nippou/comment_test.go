@@ -0,0 +1,74 @@
+package nippou
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Comment Tests
+// ============================================================================
+
+func TestNewComment(t *testing.T) {
+	nippouID := NewID()
+	posted := time.Date(2024, 1, 15, 18, 0, 0, 0, time.UTC)
+	c, err := newComment(&UUIDGenerator{}, func() time.Time { return posted }, nippouID, "  佐藤 課長\n", "良い提案です。\r\n次回は\u202E見積も添付して\u202Cください。")
+	if err != nil {
+		t.Fatalf("newComment() error = %v", err)
+	}
+	if c.ID().IsEmpty() || !c.NippouID().Equals(nippouID) {
+		t.Errorf("unexpected IDs: %s / %s", c.ID(), c.NippouID())
+	}
+	if c.Author() != "佐藤 課長" {
+		t.Errorf("Author() = %q", c.Author())
+	}
+	if c.Body() != "良い提案です。\n次回は見積も添付してください。" {
+		t.Errorf("Body() = %q", c.Body())
+	}
+	if !c.CreatedAt().Equal(posted) {
+		t.Errorf("CreatedAt() = %v, want %v", c.CreatedAt(), posted)
+	}
+}
+
+func TestNewComment_Invalid(t *testing.T) {
+	id := NewID()
+	tests := []struct {
+		name     string
+		nippouID ID
+		author   string
+		body     string
+		expected error
+	}{
+		{"missing nippou", ID{}, "a", "b", ErrMissingNippouRef},
+		{"empty author", id, " \t", "b", ErrEmptyAuthor},
+		{"long author", id, strings.Repeat("a", MaxAuthorLength+1), "b", ErrAuthorTooLong},
+		{"empty body", id, "a", "\uFEFF\u202A\n", ErrEmptyComment},
+		{"long body", id, "a", strings.Repeat("あ", MaxCommentLength+1), ErrCommentTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewComment(tt.nippouID, tt.author, tt.body); err != tt.expected {
+				t.Errorf("NewComment() error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComment_NilReceiver(t *testing.T) {
+	var c *Comment
+	if !c.ID().IsEmpty() || c.Author() != "" || c.Body() != "" || !c.CreatedAt().IsZero() {
+		t.Error("nil Comment accessors should return zero values")
+	}
+}
+
+func TestReconstructComment(t *testing.T) {
+	id, nippouID := NewID(), NewID()
+	c, err := ReconstructComment(ReconstructedComment{ID: id.String(), NippouID: nippouID.String(), Author: "a", Body: "b"})
+	if err != nil || !c.ID().Equals(id) || !c.NippouID().Equals(nippouID) {
+		t.Errorf("ReconstructComment() = %v, %v", c, err)
+	}
+	if _, err := ReconstructComment(ReconstructedComment{ID: id.String(), NippouID: "not-a-uuid"}); err == nil {
+		t.Error("ReconstructComment() should reject an invalid nippou ID")
+	}
+}
@@ -0,0 +1,214 @@
+package nippou
+
+import (
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// CivilDate Value Object - Calendar Date Without Time or Zone
+// ============================================================================
+
+// CivilDate is an immutable calendar date (year, month, day). Unlike a
+// time.Time it has no clock or zone, so "the report for 2024-01-15" means the
+// same day wherever it is read. Convert instants with CivilDateOf after
+// moving them into the user's location, e.g. CivilDateOf(now.In(loc)).
+type CivilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// NewCivilDate creates a validated CivilDate.
+// Returns ErrInvalidDateFormat if the date does not exist (e.g. February 30).
+func NewCivilDate(year int, month time.Month, day int) (CivilDate, error) {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	if t.Year() != year || t.Month() != month || t.Day() != day {
+		return CivilDate{}, ErrInvalidDateFormat
+	}
+	return CivilDate{year: year, month: month, day: day}, nil
+}
+
+// ParseCivilDate parses a YYYY-MM-DD string.
+// Returns ErrInvalidDateFormat if the string is not a valid date.
+func ParseCivilDate(s string) (CivilDate, error) {
+	t, err := time.Parse(civilDateLayout, s)
+	if err != nil {
+		return CivilDate{}, ErrInvalidDateFormat
+	}
+	return CivilDateOf(t), nil
+}
+
+// CivilDateOf returns the calendar date of t in t's own location.
+func CivilDateOf(t time.Time) CivilDate {
+	year, month, day := t.Date()
+	return CivilDate{year: year, month: month, day: day}
+}
+
+// TodayIn returns the calendar date of now as observed in loc.
+func TodayIn(now time.Time, loc *time.Location) CivilDate {
+	if loc == nil {
+		loc = DefaultLocation()
+	}
+	return CivilDateOf(now.In(loc))
+}
+
+// civilDateLayout is the canonical YYYY-MM-DD representation.
+const civilDateLayout = "2006-01-02"
+
+// ErrInvalidWeekFormat is returned for ISO week strings that do not parse.
+var ErrInvalidWeekFormat = &DomainError{Code: ErrCodeValidation, Field: "week", Message: "week must be in YYYY-Www format"}
+
+// ParseISOWeek parses an ISO 8601 week such as "2024-W03" and returns its
+// Monday. Weeks start on Monday and week 1 contains January 4th.
+func ParseISOWeek(s string) (CivilDate, error) {
+	if len(s) != 8 || s[4:6] != "-W" {
+		return CivilDate{}, ErrInvalidWeekFormat
+	}
+	year, week := 0, 0
+	for i, r := range s[:4] + s[6:] {
+		if r < '0' || r > '9' {
+			return CivilDate{}, ErrInvalidWeekFormat
+		}
+		if i < 4 {
+			year = year*10 + int(r-'0')
+		} else {
+			week = week*10 + int(r-'0')
+		}
+	}
+	if week < 1 {
+		return CivilDate{}, ErrInvalidWeekFormat
+	}
+	jan4 := CivilDate{year: year, month: time.January, day: 4}
+	monday := jan4.AddDays(-((int(jan4.Weekday()) + 6) % 7) + (week-1)*7)
+	if y, w := monday.ISOWeek(); y != year || w != week {
+		return CivilDate{}, ErrInvalidWeekFormat // e.g. W53 in a 52-week year
+	}
+	return monday, nil
+}
+
+// Year returns the year.
+func (d CivilDate) Year() int { return d.year }
+
+// Month returns the month.
+func (d CivilDate) Month() time.Month { return d.month }
+
+// Day returns the day of the month.
+func (d CivilDate) Day() int { return d.day }
+
+// IsZero reports whether d is the zero CivilDate.
+func (d CivilDate) IsZero() bool {
+	return d == CivilDate{}
+}
+
+// String returns the date in YYYY-MM-DD format, or "" for the zero date.
+func (d CivilDate) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.year, d.month, d.day)
+}
+
+// In returns midnight at the start of d in loc.
+func (d CivilDate) In(loc *time.Location) time.Time {
+	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, loc)
+}
+
+// ISOWeek returns the ISO 8601 year and week number of d.
+func (d CivilDate) ISOWeek() (year, week int) {
+	return d.In(time.UTC).ISOWeek()
+}
+
+// ISOWeekString returns d's ISO week in YYYY-Www format.
+func (d CivilDate) ISOWeekString() string {
+	year, week := d.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
+
+// Weekday returns the day of the week.
+func (d CivilDate) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// AddDays returns the date n days after d (or before, for negative n).
+func (d CivilDate) AddDays(n int) CivilDate {
+	return CivilDateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// AddMonths returns the date n months after d, normalized like time.AddDate.
+func (d CivilDate) AddMonths(n int) CivilDate {
+	return CivilDateOf(d.In(time.UTC).AddDate(0, n, 0))
+}
+
+// DaysUntil returns the number of days from d to other (negative if other is earlier).
+func (d CivilDate) DaysUntil(other CivilDate) int {
+	return int(other.In(time.UTC).Sub(d.In(time.UTC)).Hours() / 24)
+}
+
+// Compare returns -1, 0 or +1 depending on whether d is before, equal to or after other.
+func (d CivilDate) Compare(other CivilDate) int {
+	switch {
+	case d.year != other.year:
+		return compareInt(d.year, other.year)
+	case d.month != other.month:
+		return compareInt(int(d.month), int(other.month))
+	default:
+		return compareInt(d.day, other.day)
+	}
+}
+
+// Before reports whether d is before other.
+func (d CivilDate) Before(other CivilDate) bool { return d.Compare(other) < 0 }
+
+// After reports whether d is after other.
+func (d CivilDate) After(other CivilDate) bool { return d.Compare(other) > 0 }
+
+// Equals compares two dates for equality.
+func (d CivilDate) Equals(other CivilDate) bool { return d == other }
+
+// compareInt returns -1, 0 or +1 comparing a and b.
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// ============================================================================
+// Time Zone - User Location for "Today" and Range Queries
+// ============================================================================
+
+// DefaultTimeZone is the IANA name of the default user time zone.
+const DefaultTimeZone = "Asia/Tokyo"
+
+// jst is used when the host has no tz database; Japan has no DST, so a
+// fixed +09:00 offset is exact.
+var jst = time.FixedZone("JST", 9*60*60)
+
+// DefaultLocation returns the location for DefaultTimeZone.
+func DefaultLocation() *time.Location {
+	loc, err := LoadLocation(DefaultTimeZone)
+	if err != nil {
+		return jst
+	}
+	return loc
+}
+
+// LoadLocation resolves an IANA time zone name. An empty name selects
+// DefaultTimeZone. Asia/Tokyo resolves even without a tz database.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		name = DefaultTimeZone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		if name == DefaultTimeZone {
+			return jst, nil
+		}
+		return nil, &DomainError{Code: ErrCodeValidation, Field: "timeZone", Message: fmt.Sprintf("unknown time zone %q", name)}
+	}
+	return loc, nil
+}
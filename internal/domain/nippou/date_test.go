@@ -0,0 +1,127 @@
+package nippou
+
+import (
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// CivilDate Tests
+// ============================================================================
+
+func TestParseCivilDate(t *testing.T) {
+	d, err := ParseCivilDate("2024-02-29")
+	if err != nil {
+		t.Fatalf("ParseCivilDate() error = %v", err)
+	}
+	if d.Year() != 2024 || d.Month() != time.February || d.Day() != 29 || d.String() != "2024-02-29" {
+		t.Errorf("ParseCivilDate() = %v", d)
+	}
+
+	for _, input := range []string{"", "2023-02-29", "2024/01/01", "2024-01-01T00:00:00Z"} {
+		if _, err := ParseCivilDate(input); err != ErrInvalidDateFormat {
+			t.Errorf("ParseCivilDate(%q) error = %v, want ErrInvalidDateFormat", input, err)
+		}
+	}
+}
+
+func TestNewCivilDate_Invalid(t *testing.T) {
+	if _, err := NewCivilDate(2024, time.April, 31); err != ErrInvalidDateFormat {
+		t.Errorf("NewCivilDate(2024-04-31) error = %v, want ErrInvalidDateFormat", err)
+	}
+}
+
+func TestTodayIn_UsesUserTimeZone(t *testing.T) {
+	// 23:30 JST on Jan 15 is still Jan 15 in Tokyo, but 14:30 UTC
+	instant := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+
+	if got := TodayIn(instant, DefaultLocation()).String(); got != "2024-01-15" {
+		t.Errorf("TodayIn(Tokyo) = %s, want 2024-01-15", got)
+	}
+	// 00:30 JST on Jan 16 must not be filed as Jan 15
+	late := time.Date(2024, 1, 15, 15, 30, 0, 0, time.UTC)
+	if got := TodayIn(late, DefaultLocation()).String(); got != "2024-01-16" {
+		t.Errorf("TodayIn(Tokyo) = %s, want 2024-01-16", got)
+	}
+	if got := TodayIn(late, time.UTC).String(); got != "2024-01-15" {
+		t.Errorf("TodayIn(UTC) = %s, want 2024-01-15", got)
+	}
+}
+
+func TestCivilDate_Arithmetic(t *testing.T) {
+	d, _ := ParseCivilDate("2024-01-31")
+
+	if got := d.AddDays(1).String(); got != "2024-02-01" {
+		t.Errorf("AddDays(1) = %s", got)
+	}
+	if got := d.AddMonths(1).String(); got != "2024-03-02" {
+		t.Errorf("AddMonths(1) = %s", got)
+	}
+	if got := d.DaysUntil(d.AddDays(-10)); got != -10 {
+		t.Errorf("DaysUntil() = %d, want -10", got)
+	}
+	if d.Weekday() != time.Wednesday {
+		t.Errorf("Weekday() = %v, want Wednesday", d.Weekday())
+	}
+	if !d.Before(d.AddDays(1)) || !d.After(d.AddDays(-1)) || d.Compare(d) != 0 {
+		t.Error("comparison operators are inconsistent")
+	}
+	if got := d.In(DefaultLocation()).Format(time.RFC3339); got != "2024-01-31T00:00:00+09:00" {
+		t.Errorf("In(Tokyo) = %s", got)
+	}
+}
+
+func TestCivilDate_Zero(t *testing.T) {
+	var d CivilDate
+	if !d.IsZero() || d.String() != "" {
+		t.Errorf("zero CivilDate = %q", d.String())
+	}
+}
+
+func TestLoadLocation(t *testing.T) {
+	loc, err := LoadLocation("")
+	if err != nil {
+		t.Fatalf("LoadLocation(\"\") error = %v", err)
+	}
+	if _, offset := time.Date(2024, 7, 1, 0, 0, 0, 0, loc).Zone(); offset != 9*60*60 {
+		t.Errorf("default location offset = %d, want +09:00", offset)
+	}
+	if _, err := LoadLocation("Mars/Olympus_Mons"); err == nil {
+		t.Error("LoadLocation() should reject unknown zones")
+	}
+}
+
+func TestParseISOWeek(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"2024-W03", "2024-01-15", false},
+		{"2024-W01", "2024-01-01", false},
+		{"2021-W01", "2021-01-04", false},
+		{"2020-W53", "2020-12-28", false},
+		{"2021-W53", "", true},
+		{"2024-W00", "", true},
+		{"2024-W3", "", true},
+		{"2024-w03", "", true},
+		{"2024-W+3", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseISOWeek(tt.input)
+			if tt.wantErr {
+				if err != ErrInvalidWeekFormat {
+					t.Errorf("ParseISOWeek() error = %v, want ErrInvalidWeekFormat", err)
+				}
+				return
+			}
+			if err != nil || got.String() != tt.want {
+				t.Errorf("ParseISOWeek() = %v, %v; want %s", got, err, tt.want)
+			}
+			if got.ISOWeekString() != tt.input {
+				t.Errorf("ISOWeekString() = %s, want %s", got.ISOWeekString(), tt.input)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package nippou
+
+import "strings"
+
+// ============================================================================
+// Line Diff - Comparing Revision Values
+// ============================================================================
+
+// DiffOp is the kind of a diff line.
+type DiffOp byte
+
+// Diff operations, rendered as the line prefix.
+const (
+	DiffEqual  DiffOp = ' '
+	DiffDelete DiffOp = '-'
+	DiffInsert DiffOp = '+'
+)
+
+// DiffLine is one line of a line-based diff.
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// String renders the line with its "+", "-" or " " prefix.
+func (l DiffLine) String() string {
+	return string(l.Op) + " " + l.Text
+}
+
+// maxDiffCells bounds the LCS table. Beyond it the differing middle part is
+// reported as a block replacement instead of a minimal diff.
+const maxDiffCells = 4_000_000
+
+// DiffLines returns a line-based diff turning before into after. Common
+// leading and trailing lines are matched directly; the rest uses a longest
+// common subsequence, so the result is minimal for typical report sizes.
+func DiffLines(before, after string) []DiffLine {
+	a, b := splitLines(before), splitLines(after)
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var lines []DiffLine
+	for _, s := range a[:prefix] {
+		lines = append(lines, DiffLine{DiffEqual, s})
+	}
+	lines = append(lines, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, s := range a[len(a)-suffix:] {
+		lines = append(lines, DiffLine{DiffEqual, s})
+	}
+	return lines
+}
+
+// diffMiddle diffs a and b with an LCS table.
+func diffMiddle(a, b []string) []DiffLine {
+	var lines []DiffLine
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for _, s := range a {
+			lines = append(lines, DiffLine{DiffDelete, s})
+		}
+		for _, s := range b {
+			lines = append(lines, DiffLine{DiffInsert, s})
+		}
+		return lines
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, DiffLine{DiffEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, DiffLine{DiffDelete, a[i]})
+			i++
+		default:
+			lines = append(lines, DiffLine{DiffInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, DiffLine{DiffDelete, a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, DiffLine{DiffInsert, b[j]})
+	}
+	return lines
+}
+
+// splitLines splits s into lines; the empty string has no lines.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
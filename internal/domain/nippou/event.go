@@ -0,0 +1,162 @@
+package nippou
+
+import (
+	"context"
+	"time"
+)
+
+// ============================================================================
+// Domain Events - What Happened to a Nippou
+// ============================================================================
+
+// Event names, as returned by Event.EventName.
+const (
+	EventNippouCreated    = "NippouCreated"
+	EventContentUpdated   = "ContentUpdated"
+	EventTagAdded         = "TagAdded"
+	EventTagRemoved       = "TagRemoved"
+	EventLocationAttached = "LocationAttached"
+	EventLocationRemoved  = "LocationRemoved"
+	EventVoiceConfigured  = "VoiceConfigured"
+	EventVoiceRemoved     = "VoiceRemoved"
+)
+
+// Event is a fact recorded by a Nippou mutation. Events are held by the
+// entity until they are pulled after a successful save.
+type Event interface {
+	EventName() string
+	AggregateID() ID
+	OccurredAt() time.Time
+}
+
+// EventHeader carries the fields common to all Nippou events.
+type EventHeader struct {
+	NippouID ID
+	At       time.Time
+}
+
+// AggregateID returns the ID of the Nippou the event belongs to.
+func (h EventHeader) AggregateID() ID { return h.NippouID }
+
+// OccurredAt returns when the mutation happened.
+func (h EventHeader) OccurredAt() time.Time { return h.At }
+
+// NippouCreated is recorded when a Nippou is built and carries its initial
+// date, content and tags.
+type NippouCreated struct {
+	EventHeader
+	Date    CivilDate
+	Content string
+	Tags    []Tag
+}
+
+// EventName implements Event.
+func (NippouCreated) EventName() string { return EventNippouCreated }
+
+// ContentUpdated is recorded when the content changes.
+type ContentUpdated struct {
+	EventHeader
+	Previous string
+	Current  string
+}
+
+// EventName implements Event.
+func (ContentUpdated) EventName() string { return EventContentUpdated }
+
+// TagAdded is recorded when a tag is added.
+type TagAdded struct {
+	EventHeader
+	Tag Tag
+}
+
+// EventName implements Event.
+func (TagAdded) EventName() string { return EventTagAdded }
+
+// TagRemoved is recorded when a tag is removed.
+type TagRemoved struct {
+	EventHeader
+	Tag Tag
+}
+
+// EventName implements Event.
+func (TagRemoved) EventName() string { return EventTagRemoved }
+
+// LocationAttached is recorded when a location is set or replaced.
+type LocationAttached struct {
+	EventHeader
+	Location *Location
+}
+
+// EventName implements Event.
+func (LocationAttached) EventName() string { return EventLocationAttached }
+
+// LocationRemoved is recorded when the location is removed.
+type LocationRemoved struct {
+	EventHeader
+}
+
+// EventName implements Event.
+func (LocationRemoved) EventName() string { return EventLocationRemoved }
+
+// VoiceConfigured is recorded when the voice configuration is set or replaced.
+type VoiceConfigured struct {
+	EventHeader
+	Voice *VoiceConfig
+}
+
+// EventName implements Event.
+func (VoiceConfigured) EventName() string { return EventVoiceConfigured }
+
+// VoiceRemoved is recorded when the voice configuration is removed.
+type VoiceRemoved struct {
+	EventHeader
+}
+
+// EventName implements Event.
+func (VoiceRemoved) EventName() string { return EventVoiceRemoved }
+
+// Events returns the events recorded since the Nippou was built, loaded or
+// last pulled, oldest first.
+func (n *Nippou) Events() []Event {
+	if n == nil || len(n.events) == 0 {
+		return nil
+	}
+	result := make([]Event, len(n.events))
+	copy(result, n.events)
+	return result
+}
+
+// PullEvents returns the recorded events and forgets them. Callers pull after
+// the Nippou has been saved so that events describe persisted state only.
+func (n *Nippou) PullEvents() []Event {
+	if n == nil {
+		return nil
+	}
+	events := n.events
+	n.events = nil
+	return events
+}
+
+// header returns an EventHeader stamped with the last mutation time.
+func (n *Nippou) header() EventHeader {
+	return EventHeader{NippouID: n.id, At: n.updatedAt}
+}
+
+// recordEvent appends e to the pending events.
+func (n *Nippou) recordEvent(e Event) {
+	n.events = append(n.events, e)
+}
+
+// ============================================================================
+// Event Dispatcher Interface
+// ============================================================================
+
+// EventHandler reacts to a dispatched event.
+type EventHandler func(ctx context.Context, e Event) error
+
+// EventDispatcher delivers pulled events to subscribed handlers. Handler
+// failures are the dispatcher's concern: the mutation that produced the
+// events has already been saved and is not rolled back.
+type EventDispatcher interface {
+	Dispatch(ctx context.Context, events []Event)
+}
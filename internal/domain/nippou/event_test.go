@@ -0,0 +1,102 @@
+package nippou
+
+import (
+	"testing"
+	"time"
+)
+
+func eventNames(events []Event) []string {
+	names := make([]string, len(events))
+	for i, e := range events {
+		names[i] = e.EventName()
+	}
+	return names
+}
+
+func TestNippou_RecordsEvents(t *testing.T) {
+	created := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	n, err := NewNippouBuilder("2024-01-15", "report").WithTimeFunc(func() time.Time { return created }).Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	events := n.Events()
+	if len(events) != 1 {
+		t.Fatalf("Events() = %v, want NippouCreated", eventNames(events))
+	}
+	e, ok := events[0].(NippouCreated)
+	if !ok || !e.AggregateID().Equals(n.ID()) || !e.OccurredAt().Equal(created) || e.Date.String() != "2024-01-15" {
+		t.Errorf("NippouCreated = %+v", events[0])
+	}
+
+	steps := []struct {
+		name string
+		fn   func() error
+	}{
+		{"update content", func() error { return n.UpdateContent("revised") }},
+		{"same content", func() error { return n.UpdateContent("revised") }},
+		{"add tag", func() error { return n.AddTag("sales") }},
+		{"remove tag", func() error { return n.RemoveTag("SALES") }},
+		{"remove missing tag", func() error { return n.RemoveTag("sales") }},
+		{"attach location", func() error { return n.AttachLocation(35.68, 139.76, "Tokyo") }},
+		{"same location", func() error { return n.AttachLocation(35.68, 139.76, "Tokyo") }},
+		{"remove location", func() error { return n.RemoveLocation() }},
+		{"remove absent location", func() error { return n.RemoveLocation() }},
+		{"configure voice", func() error { return n.SetVoiceConfig(true, "whisper-1") }},
+		{"clear voice", func() error { return n.SetVoice(nil) }},
+	}
+	for _, step := range steps {
+		if err := step.fn(); err != nil {
+			t.Fatalf("%s: error = %v", step.name, err)
+		}
+	}
+
+	want := []string{
+		EventNippouCreated, EventContentUpdated, EventTagAdded, EventTagRemoved,
+		EventLocationAttached, EventLocationRemoved, EventVoiceConfigured, EventVoiceRemoved,
+	}
+	got := eventNames(n.PullEvents())
+	if len(got) != len(want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("events[%d] = %s, want %s", i, got[i], want[i])
+		}
+	}
+	if n.Events() != nil || n.PullEvents() != nil {
+		t.Error("events should be empty after PullEvents")
+	}
+}
+
+func TestNippou_EventPayloads(t *testing.T) {
+	n, _ := NewNippou("2024-01-15", "before")
+	n.PullEvents()
+
+	_ = n.UpdateContent(" after ")
+	_ = n.AddTag("Sales")
+	events := n.PullEvents()
+
+	content, ok := events[0].(ContentUpdated)
+	if !ok || content.Previous != "before" || content.Current != "after" {
+		t.Errorf("ContentUpdated = %+v", events[0])
+	}
+	tag, ok := events[1].(TagAdded)
+	if !ok || tag.Tag.String() != "sales" || !tag.AggregateID().Equals(n.ID()) {
+		t.Errorf("TagAdded = %+v", events[1])
+	}
+}
+
+func TestReconstruct_RecordsNoEvents(t *testing.T) {
+	n, err := Reconstruct(ReconstructedNippou{
+		ID:      "550e8400-e29b-41d4-a716-446655440000",
+		Date:    CivilDateOf(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)),
+		Content: "stored",
+	})
+	if err != nil {
+		t.Fatalf("Reconstruct() error = %v", err)
+	}
+	if events := n.Events(); events != nil {
+		t.Errorf("Events() = %v, want none", eventNames(events))
+	}
+}
@@ -2,12 +2,16 @@ package nippou
 
 import (
 	"errors"
-	"regexp"
+	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
 )
 
 // ============================================================================
@@ -55,29 +59,33 @@ func (e *DomainError) Error() string {
 
 // Error codes for domain errors.
 const (
-	ErrCodeValidation    = "VALIDATION_ERROR"
-	ErrCodeInvalidFormat = "INVALID_FORMAT"
-	ErrCodeDuplicate     = "DUPLICATE_ERROR"
-	ErrCodeLimitExceeded = "LIMIT_EXCEEDED"
-	ErrCodeNilReceiver   = "NIL_RECEIVER"
+	ErrCodeValidation      = "VALIDATION_ERROR"
+	ErrCodeInvalidFormat   = "INVALID_FORMAT"
+	ErrCodeDuplicate       = "DUPLICATE_ERROR"
+	ErrCodeLimitExceeded   = "LIMIT_EXCEEDED"
+	ErrCodeNilReceiver     = "NIL_RECEIVER"
+	ErrCodeInvalidEncoding = "INVALID_ENCODING"
 )
 
 // Predefined domain errors for common validation failures.
 var (
-	ErrEmptyContent       = &DomainError{Code: ErrCodeValidation, Field: "content", Message: "content cannot be empty"}
-	ErrContentTooLong     = &DomainError{Code: ErrCodeLimitExceeded, Field: "content", Message: "content exceeds maximum length"}
-	ErrInvalidDateFormat  = &DomainError{Code: ErrCodeInvalidFormat, Field: "date", Message: "expected YYYY-MM-DD format"}
-	ErrInvalidLatitude    = &DomainError{Code: ErrCodeValidation, Field: "latitude", Message: "must be between -90 and 90"}
-	ErrInvalidLongitude   = &DomainError{Code: ErrCodeValidation, Field: "longitude", Message: "must be between -180 and 180"}
-	ErrAddressTooLong     = &DomainError{Code: ErrCodeLimitExceeded, Field: "address", Message: "address exceeds maximum length"}
-	ErrDuplicateTag       = &DomainError{Code: ErrCodeDuplicate, Field: "tag", Message: "tag already exists"}
-	ErrTagTooLong         = &DomainError{Code: ErrCodeLimitExceeded, Field: "tag", Message: "tag exceeds maximum length"}
-	ErrEmptyTag           = &DomainError{Code: ErrCodeValidation, Field: "tag", Message: "tag cannot be empty"}
-	ErrInvalidTagFormat   = &DomainError{Code: ErrCodeInvalidFormat, Field: "tag", Message: "tag contains invalid characters"}
-	ErrMaxTagsExceeded    = &DomainError{Code: ErrCodeLimitExceeded, Field: "tags", Message: "maximum number of tags exceeded"}
-	ErrModelNameTooLong   = &DomainError{Code: ErrCodeLimitExceeded, Field: "modelName", Message: "model name exceeds maximum length"}
-	ErrEmptyModelName     = &DomainError{Code: ErrCodeValidation, Field: "modelName", Message: "model name cannot be empty when voice is enabled"}
-	ErrNilNippou          = &DomainError{Code: ErrCodeNilReceiver, Field: "nippou", Message: "operation on nil Nippou"}
+	ErrEmptyContent        = &DomainError{Code: ErrCodeValidation, Field: "content", Message: "content cannot be empty"}
+	ErrContentTooLong      = &DomainError{Code: ErrCodeLimitExceeded, Field: "content", Message: "content exceeds maximum length"}
+	ErrInvalidDateFormat   = &DomainError{Code: ErrCodeInvalidFormat, Field: "date", Message: "expected YYYY-MM-DD format"}
+	ErrInvalidLatitude     = &DomainError{Code: ErrCodeValidation, Field: "latitude", Message: "must be between -90 and 90"}
+	ErrInvalidLongitude    = &DomainError{Code: ErrCodeValidation, Field: "longitude", Message: "must be between -180 and 180"}
+	ErrAddressTooLong      = &DomainError{Code: ErrCodeLimitExceeded, Field: "address", Message: "address exceeds maximum length"}
+	ErrDuplicateTag        = &DomainError{Code: ErrCodeDuplicate, Field: "tag", Message: "tag already exists"}
+	ErrTagTooLong          = &DomainError{Code: ErrCodeLimitExceeded, Field: "tag", Message: "tag exceeds maximum length"}
+	ErrEmptyTag            = &DomainError{Code: ErrCodeValidation, Field: "tag", Message: "tag cannot be empty"}
+	ErrInvalidTagFormat    = &DomainError{Code: ErrCodeInvalidFormat, Field: "tag", Message: "tag contains invalid characters"}
+	ErrReservedTag         = &DomainError{Code: ErrCodeValidation, Field: "tag", Message: "tag is a reserved word"}
+	ErrInvalidTagEncoding  = &DomainError{Code: ErrCodeInvalidEncoding, Field: "tag", Message: "tag is not valid UTF-8"}
+	ErrInvalidTextEncoding = &DomainError{Code: ErrCodeInvalidEncoding, Field: "text", Message: "text is not valid UTF-8"}
+	ErrMaxTagsExceeded     = &DomainError{Code: ErrCodeLimitExceeded, Field: "tags", Message: "maximum number of tags exceeded"}
+	ErrModelNameTooLong    = &DomainError{Code: ErrCodeLimitExceeded, Field: "modelName", Message: "model name exceeds maximum length"}
+	ErrEmptyModelName      = &DomainError{Code: ErrCodeValidation, Field: "modelName", Message: "model name cannot be empty when voice is enabled"}
+	ErrNilNippou           = &DomainError{Code: ErrCodeNilReceiver, Field: "nippou", Message: "operation on nil Nippou"}
 )
 
 // IsValidationError checks if the error is a validation error.
@@ -167,7 +175,10 @@ func NewLocation(lat, lng float64, address string) (*Location, error) {
 	if lng < MinLongitude || lng > MaxLongitude {
 		return nil, ErrInvalidLongitude
 	}
-	sanitizedAddress := sanitizeString(address)
+	sanitizedAddress, err := SingleLineText.Normalize("address", address)
+	if err != nil {
+		return nil, err
+	}
 	if utf8.RuneCountInString(sanitizedAddress) > MaxAddressLength {
 		return nil, ErrAddressTooLong
 	}
@@ -228,7 +239,10 @@ type VoiceConfig struct {
 // NewVoiceConfig creates a new validated VoiceConfig.
 // Returns error if enabled is true but modelName is empty or too long.
 func NewVoiceConfig(enabled bool, modelName string) (*VoiceConfig, error) {
-	sanitizedModel := sanitizeString(modelName)
+	sanitizedModel, err := SingleLineText.Normalize("modelName", modelName)
+	if err != nil {
+		return nil, err
+	}
 	if enabled {
 		if sanitizedModel == "" {
 			return nil, ErrEmptyModelName
@@ -274,32 +288,77 @@ func (v *VoiceConfig) Equals(other *VoiceConfig) bool {
 // Tag Value Object - Validated Tag String
 // ============================================================================
 
-// tagPattern defines valid tag format: alphanumeric, hyphens, underscores.
-var tagPattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
-
 // Tag is a validated, immutable tag value.
+//
+// Tags may contain Unicode letters, digits, combining marks, hyphens and
+// underscores, and must start with a letter or digit, so "商談" and "訪問"
+// are valid alongside legacy ASCII tags such as "follow-up".
 type Tag struct {
 	value string
 }
 
 // NewTag creates a new validated Tag.
-// Returns error if tag is empty, too long, or contains invalid characters.
+// The value is width-folded (full-width ASCII to half-width, half-width kana
+// to full-width), NFC-normalized and lowercased, so "ＳＡＬＥＳ" and "sales"
+// are the same tag. Returns error if tag is empty, too long, contains invalid
+// characters, or is a reserved word (see SetReservedTags).
 func NewTag(value string) (Tag, error) {
-	trimmed := strings.TrimSpace(value)
-	if trimmed == "" {
+	tag, err := ParseTag(value)
+	if err != nil {
+		return Tag{}, err
+	}
+	if IsReservedTag(tag.value) {
+		return Tag{}, ErrReservedTag
+	}
+	return tag, nil
+}
+
+// ParseTag normalizes and validates a tag without the reserved-word check.
+// Use it for tags that already exist, such as search conditions or stored
+// values being migrated; reserving a word must not make those unreachable.
+// New tags are created with NewTag.
+func ParseTag(value string) (Tag, error) {
+	if !utf8.ValidString(value) {
+		return Tag{}, ErrInvalidTagEncoding
+	}
+	normalized := NormalizeTag(value)
+	if normalized == "" {
 		return Tag{}, ErrEmptyTag
 	}
-	if utf8.RuneCountInString(trimmed) > MaxTagLength {
+	if utf8.RuneCountInString(normalized) > MaxTagLength {
 		return Tag{}, ErrTagTooLong
 	}
-	// Convert to lowercase for case-insensitive comparison
-	normalized := strings.ToLower(trimmed)
-	if !tagPattern.MatchString(normalized) {
-		return Tag{}, ErrInvalidTagFormat
+	for i, r := range normalized {
+		if !isTagRune(r, i == 0) {
+			return Tag{}, ErrInvalidTagFormat
+		}
 	}
 	return Tag{value: normalized}, nil
 }
 
+// NormalizeTag returns the canonical form of a tag string: cleaned like
+// SingleLineText (repairing invalid UTF-8), width-folded, NFC-normalized and
+// lowercased. It does not validate.
+func NormalizeTag(value string) string {
+	cleaned, _ := tagText.Normalize("tag", value)
+	return strings.ToLower(norm.NFC.String(width.Fold.String(cleaned)))
+}
+
+// tagText cleans tag input before width folding.
+var tagText = TextNormalizer{RepairInvalidUTF8: true}
+
+// isTagRune reports whether r may appear in a tag; leading runes must be
+// letters or digits.
+func isTagRune(r rune, leading bool) bool {
+	if unicode.IsLetter(r) || unicode.IsDigit(r) {
+		return true
+	}
+	if leading {
+		return false
+	}
+	return r == '-' || r == '_' || unicode.Is(unicode.M, r)
+}
+
 // String returns the tag value.
 func (t Tag) String() string {
 	return t.value
@@ -310,6 +369,51 @@ func (t Tag) Equals(other Tag) bool {
 	return t.value == other.value
 }
 
+// ============================================================================
+// Reserved Tags - Configurable Deny List
+// ============================================================================
+
+// reservedTags holds the normalized reserved words rejected by NewTag.
+var reservedTags atomic.Pointer[map[string]struct{}]
+
+// SetReservedTags replaces the words NewTag rejects. Words are normalized
+// like tags, so reserving "ALL" also rejects "ａｌｌ". Calling it with no
+// arguments clears the list. Existing tags loaded through Reconstruct are
+// not affected.
+func SetReservedTags(words ...string) {
+	set := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		if normalized := NormalizeTag(word); normalized != "" {
+			set[normalized] = struct{}{}
+		}
+	}
+	reservedTags.Store(&set)
+}
+
+// ReservedTags returns the current reserved words in normalized form.
+func ReservedTags() []string {
+	set := reservedTags.Load()
+	if set == nil {
+		return nil
+	}
+	words := make([]string, 0, len(*set))
+	for word := range *set {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	return words
+}
+
+// IsReservedTag reports whether value normalizes to a reserved word.
+func IsReservedTag(value string) bool {
+	set := reservedTags.Load()
+	if set == nil {
+		return false
+	}
+	_, reserved := (*set)[NormalizeTag(value)]
+	return reserved
+}
+
 // ============================================================================
 // Nippou Entity - Core Domain Entity
 // ============================================================================
@@ -318,13 +422,15 @@ func (t Tag) Equals(other Tag) bool {
 // All fields are private to ensure invariants are maintained.
 type Nippou struct {
 	id        ID
-	date      time.Time
+	date      CivilDate
 	content   string
 	location  *Location
 	voice     *VoiceConfig
 	tags      []Tag
 	createdAt time.Time
 	updatedAt time.Time
+	changes   []Change // Unsaved content/tag/location edits, oldest first
+	events    []Event  // Recorded domain events not yet pulled
 }
 
 // NippouBuilder provides a fluent API for creating Nippou entities.
@@ -379,30 +485,37 @@ func (b *NippouBuilder) WithTimeFunc(fn func() time.Time) *NippouBuilder {
 	return b
 }
 
-// Build creates the Nippou entity after validating all inputs.
+// Build creates the Nippou entity after validating all inputs. Every problem
+// found is returned together as ValidationErrors.
 func (b *NippouBuilder) Build() (*Nippou, error) {
+	var errs ValidationErrors
+
 	// Validate and sanitize content
-	sanitizedContent := sanitizeString(b.content)
-	if sanitizedContent == "" {
-		return nil, ErrEmptyContent
-	}
-	if utf8.RuneCountInString(sanitizedContent) > MaxContentLength {
-		return nil, ErrContentTooLong
+	sanitizedContent, err := MultilineText.Normalize("content", b.content)
+	switch {
+	case err != nil:
+		errs.Add(err)
+	case sanitizedContent == "":
+		errs.Add(ErrEmptyContent)
+	case utf8.RuneCountInString(sanitizedContent) > MaxContentLength:
+		errs.Add(ErrContentTooLong)
 	}
 
 	// Parse and validate date
-	date, err := time.Parse("2006-01-02", b.dateStr)
-	if err != nil {
-		return nil, ErrInvalidDateFormat
-	}
+	date, err := ParseCivilDate(b.dateStr)
+	errs.Add(err)
 
 	// Validate tag count
 	if len(b.tags) > MaxTagCount {
-		return nil, ErrMaxTagsExceeded
+		errs.Add(ErrMaxTagsExceeded)
+	}
+
+	if err := errs.Err(); err != nil {
+		return nil, err
 	}
 
 	now := b.timeFunc()
-	return &Nippou{
+	n := &Nippou{
 		id:        b.idGen.Generate(),
 		date:      date,
 		content:   sanitizedContent,
@@ -411,7 +524,9 @@ func (b *NippouBuilder) Build() (*Nippou, error) {
 		tags:      copyTags(b.tags),
 		createdAt: now,
 		updatedAt: now,
-	}, nil
+	}
+	n.recordEvent(NippouCreated{EventHeader: n.header(), Date: date, Content: n.content, Tags: copyTags(n.tags)})
+	return n, nil
 }
 
 // NewNippou creates a new Nippou entity with the given date and content.
@@ -433,10 +548,10 @@ func (n *Nippou) ID() ID {
 	return n.id
 }
 
-// Date returns the report date.
-func (n *Nippou) Date() time.Time {
+// Date returns the report date (a calendar date with no time or zone).
+func (n *Nippou) Date() CivilDate {
 	if n == nil {
-		return time.Time{}
+		return CivilDate{}
 	}
 	return n.date
 }
@@ -519,15 +634,23 @@ func (n *Nippou) UpdateContent(content string) error {
 	if n == nil {
 		return ErrNilNippou
 	}
-	sanitized := sanitizeString(content)
+	sanitized, err := MultilineText.Normalize("content", content)
+	if err != nil {
+		return err
+	}
 	if sanitized == "" {
 		return ErrEmptyContent
 	}
 	if utf8.RuneCountInString(sanitized) > MaxContentLength {
 		return ErrContentTooLong
 	}
+	previous := n.content
 	n.content = sanitized
 	n.updatedAt = time.Now()
+	n.recordChange(RevisionContent, previous, n.content)
+	if previous != sanitized {
+		n.recordEvent(ContentUpdated{EventHeader: n.header(), Previous: previous, Current: sanitized})
+	}
 	return nil
 }
 
@@ -540,9 +663,7 @@ func (n *Nippou) AttachLocation(lat, lng float64, address string) error {
 	if err != nil {
 		return err
 	}
-	n.location = loc
-	n.updatedAt = time.Now()
-	return nil
+	return n.SetLocation(loc)
 }
 
 // SetLocation sets a pre-validated location.
@@ -550,8 +671,16 @@ func (n *Nippou) SetLocation(loc *Location) error {
 	if n == nil {
 		return ErrNilNippou
 	}
+	previous := n.location
 	n.location = loc
 	n.updatedAt = time.Now()
+	n.recordChange(RevisionLocation, locationValue(previous), locationValue(n.location))
+	switch {
+	case loc == nil && previous != nil:
+		n.recordEvent(LocationRemoved{EventHeader: n.header()})
+	case loc != nil && !loc.Equals(previous):
+		n.recordEvent(LocationAttached{EventHeader: n.header(), Location: loc})
+	}
 	return nil
 }
 
@@ -560,8 +689,13 @@ func (n *Nippou) RemoveLocation() error {
 	if n == nil {
 		return ErrNilNippou
 	}
+	previous := n.location
 	n.location = nil
 	n.updatedAt = time.Now()
+	n.recordChange(RevisionLocation, locationValue(previous), "")
+	if previous != nil {
+		n.recordEvent(LocationRemoved{EventHeader: n.header()})
+	}
 	return nil
 }
 
@@ -574,9 +708,7 @@ func (n *Nippou) SetVoiceConfig(enabled bool, modelName string) error {
 	if err != nil {
 		return err
 	}
-	n.voice = voice
-	n.updatedAt = time.Now()
-	return nil
+	return n.SetVoice(voice)
 }
 
 // SetVoice sets a pre-validated voice config.
@@ -584,8 +716,15 @@ func (n *Nippou) SetVoice(voice *VoiceConfig) error {
 	if n == nil {
 		return ErrNilNippou
 	}
+	previous := n.voice
 	n.voice = voice
 	n.updatedAt = time.Now()
+	switch {
+	case voice == nil && previous != nil:
+		n.recordEvent(VoiceRemoved{EventHeader: n.header()})
+	case voice != nil && !voice.Equals(previous):
+		n.recordEvent(VoiceConfigured{EventHeader: n.header(), Voice: voice})
+	}
 	return nil
 }
 
@@ -594,8 +733,12 @@ func (n *Nippou) RemoveVoice() error {
 	if n == nil {
 		return ErrNilNippou
 	}
+	previous := n.voice
 	n.voice = nil
 	n.updatedAt = time.Now()
+	if previous != nil {
+		n.recordEvent(VoiceRemoved{EventHeader: n.header()})
+	}
 	return nil
 }
 
@@ -624,36 +767,44 @@ func (n *Nippou) AddValidatedTag(tag Tag) error {
 			return ErrDuplicateTag
 		}
 	}
+	previous := tagsValue(n.tags)
 	n.tags = append(n.tags, tag)
 	n.updatedAt = time.Now()
+	n.recordChange(RevisionTags, previous, tagsValue(n.tags))
+	n.recordEvent(TagAdded{EventHeader: n.header(), Tag: tag})
 	return nil
 }
 
-// RemoveTag removes a tag by value.
+// RemoveTag removes a tag by value. Reserved words are accepted so that tags
+// stored before they were reserved can still be removed.
 func (n *Nippou) RemoveTag(tagStr string) error {
 	if n == nil {
 		return ErrNilNippou
 	}
-	tag, err := NewTag(tagStr)
+	tag, err := ParseTag(tagStr)
 	if err != nil {
 		return err
 	}
 	for i, t := range n.tags {
 		if t.Equals(tag) {
+			previous := tagsValue(n.tags)
 			n.tags = append(n.tags[:i], n.tags[i+1:]...)
 			n.updatedAt = time.Now()
+			n.recordChange(RevisionTags, previous, tagsValue(n.tags))
+			n.recordEvent(TagRemoved{EventHeader: n.header(), Tag: t})
 			return nil
 		}
 	}
 	return nil // Not found is not an error
 }
 
-// HasTag checks if the Nippou has the specified tag (case-insensitive).
+// HasTag checks if the Nippou has the specified tag (case-insensitive),
+// including a stored tag that is now a reserved word.
 func (n *Nippou) HasTag(tagStr string) bool {
 	if n == nil {
 		return false
 	}
-	tag, err := NewTag(tagStr)
+	tag, err := ParseTag(tagStr)
 	if err != nil {
 		return false
 	}
@@ -680,7 +831,17 @@ func (n *Nippou) TagCount() int {
 // Reader defines read operations for Nippou persistence.
 type Reader interface {
 	FindByID(id ID) (*Nippou, error)
-	FindByDate(date time.Time) ([]*Nippou, error)
+	FindByDate(date CivilDate) ([]*Nippou, error)
+}
+
+// DateRangeReader defines inclusive date range queries for Nippou persistence.
+type DateRangeReader interface {
+	FindByDateRange(startDate, endDate CivilDate) ([]*Nippou, error)
+}
+
+// TagReader defines tag-based queries for Nippou persistence.
+type TagReader interface {
+	FindByTag(tag string) ([]*Nippou, error)
 }
 
 // Writer defines write operations for Nippou persistence.
@@ -703,7 +864,7 @@ type Repository interface {
 // ReconstructedNippou contains all fields needed to reconstruct a Nippou from storage.
 type ReconstructedNippou struct {
 	ID        string
-	Date      time.Time
+	Date      CivilDate
 	Content   string
 	Location  *Location
 	Voice     *VoiceConfig
@@ -722,7 +883,9 @@ func Reconstruct(data ReconstructedNippou) (*Nippou, error) {
 
 	tags := make([]Tag, 0, len(data.Tags))
 	for _, tagStr := range data.Tags {
-		tag, err := NewTag(tagStr)
+		// Stored tags bypass the reserved-word check so that reserving a
+		// word later does not silently drop it from existing reports
+		tag, err := ParseTag(tagStr)
 		if err != nil {
 			// Skip invalid tags during reconstruction
 			continue
@@ -746,18 +909,6 @@ func Reconstruct(data ReconstructedNippou) (*Nippou, error) {
 // Helper Functions - Internal Utilities
 // ============================================================================
 
-// sanitizeString removes potentially dangerous characters and trims whitespace.
-func sanitizeString(s string) string {
-	// Trim whitespace
-	s = strings.TrimSpace(s)
-	// Remove null bytes (potential security issue)
-	s = strings.ReplaceAll(s, "\x00", "")
-	// Normalize line endings to LF
-	s = strings.ReplaceAll(s, "\r\n", "\n")
-	s = strings.ReplaceAll(s, "\r", "\n")
-	return s
-}
-
 // copyTags creates a defensive copy of a tag slice.
 func copyTags(tags []Tag) []Tag {
 	if tags == nil {
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 )
 
 // ============================================================================
@@ -341,6 +342,15 @@ func TestNewTag_Success(t *testing.T) {
 		{"tag_with_underscore", "tag_with_underscore"},
 		{"tag123", "tag123"},
 		{"  trimmed  ", "trimmed"},
+		{"商談", "商談"},
+		{"訪問2024", "訪問2024"},
+		{"ｓａｌｅｓ", "sales"},
+		{"ＳＡＬＥＳ－１", "sales-1"},
+		{"ｼｮｳﾀﾞﾝ", "ショウダン"},
+		{"\u3000商談\u3000", "商談"},
+		{"カ\u3099イギ", "ガイギ"},
+		{"Café", "café"},
+		{"プロジェクト_α", "プロジェクト_α"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.input, func(t *testing.T) {
@@ -381,6 +391,10 @@ func TestNewTag_InvalidFormat(t *testing.T) {
 		"-starts-with-dash",
 		"_starts-with-underscore",
 		"tag!exclaim",
+		"商談・訪問",
+		"商談　訪問",
+		"a;b",
+		"50%",
 	}
 	for _, input := range tests {
 		_, err := NewTag(input)
@@ -390,6 +404,76 @@ func TestNewTag_InvalidFormat(t *testing.T) {
 	}
 }
 
+func TestNewTag_TooLongCountsRunes(t *testing.T) {
+	if _, err := NewTag(strings.Repeat("商", MaxTagLength)); err != nil {
+		t.Errorf("NewTag() with %d runes error = %v", MaxTagLength, err)
+	}
+	if _, err := NewTag(strings.Repeat("商", MaxTagLength+1)); err != ErrTagTooLong {
+		t.Errorf("NewTag() with long tag error = %v, want ErrTagTooLong", err)
+	}
+}
+
+func TestNewTag_Reserved(t *testing.T) {
+	SetReservedTags("ALL", "なし")
+	defer SetReservedTags()
+
+	for _, input := range []string{"all", "ＡＬＬ", "なし"} {
+		if _, err := NewTag(input); err != ErrReservedTag {
+			t.Errorf("NewTag(%q) error = %v, want ErrReservedTag", input, err)
+		}
+	}
+	if _, err := NewTag("allowed"); err != nil {
+		t.Errorf("NewTag(%q) error = %v", "allowed", err)
+	}
+	if got := strings.Join(ReservedTags(), ","); got != "all,なし" {
+		t.Errorf("ReservedTags() = %q", got)
+	}
+	if tag, err := ParseTag("ＡＬＬ"); err != nil || tag.String() != "all" {
+		t.Errorf("ParseTag(reserved) = %q, %v; want the normalized tag", tag.String(), err)
+	}
+	if _, err := ParseTag("bad tag"); err != ErrInvalidTagFormat {
+		t.Errorf("ParseTag(%q) error = %v, want ErrInvalidTagFormat", "bad tag", err)
+	}
+
+	n, err := Reconstruct(ReconstructedNippou{
+		ID:   "550e8400-e29b-41d4-a716-446655440000",
+		Date: CivilDateOf(time.Now()),
+		Tags: []string{"all", "legacy_tag", "bad tag"},
+	})
+	if err != nil {
+		t.Fatalf("Reconstruct() error = %v", err)
+	}
+	if got := strings.Join(n.TagStrings(), ","); got != "all,legacy_tag" {
+		t.Errorf("Reconstruct() tags = %q, want reserved and legacy tags kept", got)
+	}
+}
+
+func TestNippou_StoredReservedTag(t *testing.T) {
+	SetReservedTags("all")
+	defer SetReservedTags()
+
+	n, err := Reconstruct(ReconstructedNippou{
+		ID:   "550e8400-e29b-41d4-a716-446655440000",
+		Date: CivilDateOf(time.Now()),
+		Tags: []string{"all", "sales"},
+	})
+	if err != nil {
+		t.Fatalf("Reconstruct() error = %v", err)
+	}
+	if !n.HasTag("ALL") {
+		t.Error("HasTag() should find a stored reserved tag")
+	}
+	if err := n.AddTag("all"); err != ErrReservedTag {
+		t.Errorf("AddTag() error = %v, want ErrReservedTag", err)
+	}
+	if err := n.RemoveTag("all"); err != nil {
+		t.Fatalf("RemoveTag() error = %v", err)
+	}
+	if n.HasTag("all") || strings.Join(n.TagStrings(), ",") != "sales" {
+		t.Errorf("tags after RemoveTag() = %v", n.TagStrings())
+	}
+}
+
 func TestTag_Equals(t *testing.T) {
 	tag1, _ := NewTag("important")
 	tag2, _ := NewTag("IMPORTANT")
@@ -401,6 +485,11 @@ func TestTag_Equals(t *testing.T) {
 	if tag1.Equals(tag3) {
 		t.Error("Different tags should not be equal")
 	}
+
+	fullWidth, _ := NewTag("ＩＭＰＯＲＴＡＮＴ")
+	if !tag1.Equals(fullWidth) {
+		t.Error("Full-width tags should equal their half-width form")
+	}
 }
 
 // ============================================================================
@@ -421,8 +510,8 @@ func TestNewNippou_Success(t *testing.T) {
 	if n.Content() != "Today's report content" {
 		t.Errorf("Content() = %q, want 'Today's report content'", n.Content())
 	}
-	expectedDate, _ := time.Parse("2006-01-02", "2026-01-08")
-	if !n.Date().Equal(expectedDate) {
+	expectedDate, _ := ParseCivilDate("2026-01-08")
+	if !n.Date().Equals(expectedDate) {
 		t.Errorf("Date() = %v, want %v", n.Date(), expectedDate)
 	}
 	if n.CreatedAt().IsZero() {
@@ -441,14 +530,14 @@ func TestNewNippou_Success(t *testing.T) {
 
 func TestNewNippou_EmptyContent(t *testing.T) {
 	_, err := NewNippou("2026-01-08", "")
-	if err != ErrEmptyContent {
+	if !errors.Is(err, ErrEmptyContent) {
 		t.Errorf("NewNippou() error = %v, want ErrEmptyContent", err)
 	}
 }
 
 func TestNewNippou_WhitespaceOnlyContent(t *testing.T) {
 	_, err := NewNippou("2026-01-08", "   \t\n  ")
-	if err != ErrEmptyContent {
+	if !errors.Is(err, ErrEmptyContent) {
 		t.Errorf("NewNippou() with whitespace content error = %v, want ErrEmptyContent", err)
 	}
 }
@@ -456,7 +545,7 @@ func TestNewNippou_WhitespaceOnlyContent(t *testing.T) {
 func TestNewNippou_ContentTooLong(t *testing.T) {
 	longContent := strings.Repeat("a", MaxContentLength+1)
 	_, err := NewNippou("2026-01-08", longContent)
-	if err != ErrContentTooLong {
+	if !errors.Is(err, ErrContentTooLong) {
 		t.Errorf("NewNippou() with long content error = %v, want ErrContentTooLong", err)
 	}
 }
@@ -487,7 +576,7 @@ func TestNewNippou_InvalidDateFormat(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		_, err := NewNippou(tc, "content")
-		if err != ErrInvalidDateFormat {
+		if !errors.Is(err, ErrInvalidDateFormat) {
 			t.Errorf("NewNippou(%q, 'content') error = %v, want ErrInvalidDateFormat", tc, err)
 		}
 	}
@@ -582,7 +671,7 @@ func TestNippouBuilder_TooManyTags(t *testing.T) {
 		WithTags(tags).
 		Build()
 
-	if err != ErrMaxTagsExceeded {
+	if !errors.Is(err, ErrMaxTagsExceeded) {
 		t.Errorf("Build() with too many tags error = %v, want ErrMaxTagsExceeded", err)
 	}
 }
@@ -598,7 +687,7 @@ func TestNippou_NilSafeGetters(t *testing.T) {
 		t.Error("nil Nippou.ID().String() should return empty string")
 	}
 	if !n.Date().IsZero() {
-		t.Error("nil Nippou.Date() should return zero time")
+		t.Error("nil Nippou.Date() should return zero date")
 	}
 	if n.Content() != "" {
 		t.Error("nil Nippou.Content() should return empty string")
@@ -1026,7 +1115,7 @@ func TestReconstruct_Success(t *testing.T) {
 
 	data := ReconstructedNippou{
 		ID:        "550e8400-e29b-41d4-a716-446655440000",
-		Date:      time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC),
+		Date:      CivilDateOf(time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC)),
 		Content:   "Test content",
 		Location:  loc,
 		Voice:     voice,
@@ -1081,7 +1170,7 @@ func TestReconstruct_SkipsInvalidTags(t *testing.T) {
 // Helper Function Tests
 // ============================================================================
 
-func TestSanitizeString(t *testing.T) {
+func TestTextNormalizer_Multiline(t *testing.T) {
 	tests := []struct {
 		input    string
 		expected string
@@ -1092,17 +1181,95 @@ func TestSanitizeString(t *testing.T) {
 		{"mac\rline", "mac\nline"},
 		{"unix\nline", "unix\nline"},
 		{"mixed\r\n\r\nlines", "mixed\n\nlines"},
+		{"\uFEFFbom at start", "bom at start"},
+		{"zero\uFEFFwidth", "zerowidth"},
+		{"tab\tkept", "tab\tkept"},
+		{"bell\a and esc\x1b and c1\u0085", "bell and esc and c1"},
+		{"safe\u202Eevil\u202C", "safeevil"},
+		{"isolate\u2066x\u2069", "isolatex"},
+		{"カ\u3099イギ", "ガイギ"},
+		{"e\u0301", "\u00e9"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.input, func(t *testing.T) {
-			result := sanitizeString(tt.input)
+			result, err := MultilineText.Normalize("content", tt.input)
+			if err != nil {
+				t.Fatalf("Normalize(%q) error = %v", tt.input, err)
+			}
 			if result != tt.expected {
-				t.Errorf("sanitizeString(%q) = %q, want %q", tt.input, result, tt.expected)
+				t.Errorf("Normalize(%q) = %q, want %q", tt.input, result, tt.expected)
 			}
 		})
 	}
 }
 
+func TestTextNormalizer_SingleLine(t *testing.T) {
+	result, err := SingleLineText.Normalize("address", "Tokyo\r\nShibuya\tStation")
+	if err != nil {
+		t.Fatalf("Normalize() error = %v", err)
+	}
+	if result != "Tokyo Shibuya Station" {
+		t.Errorf("Normalize() = %q, want line breaks replaced by spaces", result)
+	}
+}
+
+func TestTextNormalizer_InvalidUTF8(t *testing.T) {
+	inputs := []string{
+		"bad\xffbyte",
+		"\xed\xa0\x80 lone surrogate",
+		"truncated \xe6\x97",
+	}
+	for _, input := range inputs {
+		_, err := MultilineText.Normalize("content", input)
+		var domErr *DomainError
+		if !errors.As(err, &domErr) || domErr.Code != ErrCodeInvalidEncoding || domErr.Field != "content" {
+			t.Errorf("Normalize(%q) error = %v, want INVALID_ENCODING for content", input, err)
+		}
+		if !errors.Is(err, ErrInvalidTextEncoding) || errors.Is(err, ErrInvalidTagEncoding) {
+			t.Errorf("Normalize(%q) error = %v, want ErrInvalidTextEncoding only", input, err)
+		}
+
+		repaired, err := TextNormalizer{Multiline: true, RepairInvalidUTF8: true}.Normalize("content", input)
+		if err != nil {
+			t.Errorf("repairing Normalize(%q) error = %v", input, err)
+		}
+		if !utf8.ValidString(repaired) || !strings.ContainsRune(repaired, utf8.RuneError) {
+			t.Errorf("repairing Normalize(%q) = %q, want U+FFFD replacement", input, repaired)
+		}
+	}
+}
+
+func TestTextNormalizer_AppliedToFields(t *testing.T) {
+	if _, err := NewNippou("2024-01-15", "bad\xff"); err == nil {
+		t.Error("NewNippou() should reject invalid UTF-8 content")
+	}
+	if _, err := NewLocation(0, 0, "bad\xff"); err == nil {
+		t.Error("NewLocation() should reject invalid UTF-8 address")
+	}
+	if _, err := NewVoiceConfig(true, "bad\xff"); err == nil {
+		t.Error("NewVoiceConfig() should reject invalid UTF-8 model name")
+	}
+	if _, err := NewTag("bad\xff"); err != ErrInvalidTagEncoding {
+		t.Errorf("NewTag() error = %v, want ErrInvalidTagEncoding", err)
+	}
+
+	n, err := NewNippou("2024-01-15", "\uFEFF本日は\u202E商談\r\n")
+	if err != nil {
+		t.Fatalf("NewNippou() error = %v", err)
+	}
+	if n.Content() != "本日は商談" {
+		t.Errorf("Content() = %q", n.Content())
+	}
+	if err := n.UpdateContent("\x00\uFEFF"); err != ErrEmptyContent {
+		t.Errorf("UpdateContent() error = %v, want ErrEmptyContent", err)
+	}
+
+	tag, err := NewTag("\u202E商談")
+	if err != nil || tag.String() != "商談" {
+		t.Errorf("NewTag() = %q, %v", tag.String(), err)
+	}
+}
+
 // ============================================================================
 // UpdatedAt Tracking Tests
 // ============================================================================
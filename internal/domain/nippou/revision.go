@@ -0,0 +1,253 @@
+package nippou
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ============================================================================
+// Change Tracking - Unsaved Edits on a Nippou
+// ============================================================================
+
+// RevisionField identifies the part of a Nippou a revision changed.
+type RevisionField string
+
+const (
+	// RevisionContent records a content edit; values are the full content.
+	RevisionContent RevisionField = "content"
+	// RevisionTags records tag edits; values list one tag per line.
+	RevisionTags RevisionField = "tags"
+	// RevisionLocation records location edits; values are "lat,lng address".
+	RevisionLocation RevisionField = "location"
+)
+
+// IsValid reports whether f is a known revision field.
+func (f RevisionField) IsValid() bool {
+	switch f {
+	case RevisionContent, RevisionTags, RevisionLocation:
+		return true
+	}
+	return false
+}
+
+// Change is an edit made to a Nippou that has not been recorded as a
+// Revision yet. Values are textual so they can be stored and diffed.
+type Change struct {
+	Field     RevisionField
+	Previous  string
+	Current   string
+	ChangedAt time.Time
+}
+
+// Changes returns the unsaved edits since the Nippou was loaded or last
+// cleared, one per field. Repeated edits to a field are merged, keeping the
+// first previous value, and edits that restore the original value vanish.
+func (n *Nippou) Changes() []Change {
+	if n == nil || len(n.changes) == 0 {
+		return nil
+	}
+	result := make([]Change, len(n.changes))
+	copy(result, n.changes)
+	return result
+}
+
+// ClearChanges forgets the unsaved edits, typically after they were recorded.
+func (n *Nippou) ClearChanges() {
+	if n != nil {
+		n.changes = nil
+	}
+}
+
+// recordChange adds or merges a pending change for field.
+func (n *Nippou) recordChange(field RevisionField, previous, current string) {
+	for i := range n.changes {
+		if n.changes[i].Field != field {
+			continue
+		}
+		if n.changes[i].Previous == current {
+			n.changes = append(n.changes[:i], n.changes[i+1:]...)
+			return
+		}
+		n.changes[i].Current = current
+		n.changes[i].ChangedAt = n.updatedAt
+		return
+	}
+	if previous != current {
+		n.changes = append(n.changes, Change{Field: field, Previous: previous, Current: current, ChangedAt: n.updatedAt})
+	}
+}
+
+// tagsValue renders tags one per line for revision values.
+func tagsValue(tags []Tag) string {
+	values := make([]string, len(tags))
+	for i, t := range tags {
+		values[i] = t.String()
+	}
+	return strings.Join(values, "\n")
+}
+
+// locationValue renders a location for revision values ("" for none).
+func locationValue(loc *Location) string {
+	if loc == nil {
+		return ""
+	}
+	return strings.TrimSpace(fmt.Sprintf("%.7f,%.7f %s", loc.Latitude(), loc.Longitude(), loc.Address()))
+}
+
+// ============================================================================
+// Revision Entity - Recorded Edit With Author
+// ============================================================================
+
+// Revision errors.
+var (
+	ErrInvalidRevisionField = &DomainError{Code: ErrCodeValidation, Field: "field", Message: "unknown revision field"}
+	ErrMissingRevisionRef   = &DomainError{Code: ErrCodeValidation, Field: "nippouId", Message: "revision must belong to a nippou"}
+)
+
+// Revision is an immutable record of one change to a Nippou, kept for
+// compliance. Previous and Current hold the whole field value.
+type Revision struct {
+	id        ID
+	nippouID  ID
+	field     RevisionField
+	author    string
+	previous  string
+	current   string
+	changedAt time.Time
+}
+
+// NewRevision records change as made by author on the Nippou nippouID.
+func NewRevision(nippouID ID, author string, change Change) (*Revision, error) {
+	if nippouID.IsEmpty() {
+		return nil, ErrMissingRevisionRef
+	}
+	if !change.Field.IsValid() {
+		return nil, ErrInvalidRevisionField
+	}
+	author, err := SingleLineText.Normalize("author", author)
+	if err != nil {
+		return nil, err
+	}
+	if author == "" {
+		return nil, ErrEmptyAuthor
+	}
+	if utf8.RuneCountInString(author) > MaxAuthorLength {
+		return nil, ErrAuthorTooLong
+	}
+	return &Revision{
+		id:        NewID(),
+		nippouID:  nippouID,
+		field:     change.Field,
+		author:    author,
+		previous:  change.Previous,
+		current:   change.Current,
+		changedAt: change.ChangedAt,
+	}, nil
+}
+
+// ID returns the revision's unique identifier.
+func (r *Revision) ID() ID {
+	if r == nil {
+		return ID{}
+	}
+	return r.id
+}
+
+// NippouID returns the ID of the revised Nippou.
+func (r *Revision) NippouID() ID {
+	if r == nil {
+		return ID{}
+	}
+	return r.nippouID
+}
+
+// Field returns the revised field.
+func (r *Revision) Field() RevisionField {
+	if r == nil {
+		return ""
+	}
+	return r.field
+}
+
+// Author returns who made the change.
+func (r *Revision) Author() string {
+	if r == nil {
+		return ""
+	}
+	return r.author
+}
+
+// Previous returns the field value before the change.
+func (r *Revision) Previous() string {
+	if r == nil {
+		return ""
+	}
+	return r.previous
+}
+
+// Current returns the field value after the change.
+func (r *Revision) Current() string {
+	if r == nil {
+		return ""
+	}
+	return r.current
+}
+
+// ChangedAt returns when the change was made.
+func (r *Revision) ChangedAt() time.Time {
+	if r == nil {
+		return time.Time{}
+	}
+	return r.changedAt
+}
+
+// ============================================================================
+// Revision Repository Interface
+// ============================================================================
+
+// RevisionRepository defines persistence for the revision log.
+// Revisions are append-only.
+type RevisionRepository interface {
+	SaveRevisions(revisions []*Revision) error
+	// FindRevisions returns the revisions of a Nippou, oldest first.
+	FindRevisions(nippouID ID) ([]*Revision, error)
+}
+
+// ReconstructedRevision contains all fields needed to reconstruct a Revision from storage.
+type ReconstructedRevision struct {
+	ID        string
+	NippouID  string
+	Field     string
+	Author    string
+	Previous  string
+	Current   string
+	ChangedAt time.Time
+}
+
+// ReconstructRevision creates a Revision from stored data without validation.
+// This should only be used by repository implementations.
+func ReconstructRevision(data ReconstructedRevision) (*Revision, error) {
+	id, err := IDFromString(data.ID)
+	if err != nil {
+		return nil, err
+	}
+	nippouID, err := IDFromString(data.NippouID)
+	if err != nil {
+		return nil, err
+	}
+	field := RevisionField(data.Field)
+	if !field.IsValid() {
+		return nil, ErrInvalidRevisionField
+	}
+	return &Revision{
+		id:        id,
+		nippouID:  nippouID,
+		field:     field,
+		author:    data.Author,
+		previous:  data.Previous,
+		current:   data.Current,
+		changedAt: data.ChangedAt,
+	}, nil
+}
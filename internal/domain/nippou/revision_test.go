@@ -0,0 +1,127 @@
+package nippou
+
+import (
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// Change Tracking Tests
+// ============================================================================
+
+func TestNippou_Changes(t *testing.T) {
+	n, _ := NewNippou("2024-01-15", "line 1\nline 2")
+	if len(n.Changes()) != 0 {
+		t.Fatalf("new Nippou should have no changes: %+v", n.Changes())
+	}
+
+	_ = n.UpdateContent("line 1\nline 2 edited")
+	_ = n.UpdateContent("line 1\nline 2 edited twice")
+	_ = n.AddTag("sales")
+	_ = n.AddTag("visit")
+	_ = n.AttachLocation(35.6812, 139.7671, "Tokyo Station")
+
+	changes := n.Changes()
+	if len(changes) != 3 {
+		t.Fatalf("Changes() = %+v, want one per field", changes)
+	}
+	if c := changes[0]; c.Field != RevisionContent || c.Previous != "line 1\nline 2" || c.Current != "line 1\nline 2 edited twice" {
+		t.Errorf("content change = %+v", c)
+	}
+	if c := changes[1]; c.Field != RevisionTags || c.Previous != "" || c.Current != "sales\nvisit" {
+		t.Errorf("tags change = %+v", c)
+	}
+	if c := changes[2]; c.Field != RevisionLocation || c.Current != "35.6812000,139.7671000 Tokyo Station" || c.ChangedAt.IsZero() {
+		t.Errorf("location change = %+v", c)
+	}
+
+	n.ClearChanges()
+	if len(n.Changes()) != 0 {
+		t.Error("ClearChanges() should forget changes")
+	}
+}
+
+func TestNippou_Changes_RevertedEditVanishes(t *testing.T) {
+	n, _ := NewNippou("2024-01-15", "original")
+	_ = n.UpdateContent("edited")
+	_ = n.UpdateContent("original")
+	_ = n.RemoveLocation()
+	_ = n.RemoveTag("absent")
+	if changes := n.Changes(); len(changes) != 0 {
+		t.Errorf("Changes() = %+v, want none", changes)
+	}
+}
+
+// ============================================================================
+// Revision Tests
+// ============================================================================
+
+func TestNewRevision(t *testing.T) {
+	nippouID := NewID()
+	r, err := NewRevision(nippouID, " 佐藤 ", Change{Field: RevisionContent, Previous: "a", Current: "b"})
+	if err != nil {
+		t.Fatalf("NewRevision() error = %v", err)
+	}
+	if r.ID().IsEmpty() || !r.NippouID().Equals(nippouID) || r.Author() != "佐藤" || r.Previous() != "a" || r.Current() != "b" {
+		t.Errorf("unexpected revision: %+v", r)
+	}
+
+	tests := []struct {
+		name     string
+		id       ID
+		author   string
+		field    RevisionField
+		expected error
+	}{
+		{"missing nippou", ID{}, "a", RevisionContent, ErrMissingRevisionRef},
+		{"unknown field", nippouID, "a", "voice", ErrInvalidRevisionField},
+		{"empty author", nippouID, " ", RevisionTags, ErrEmptyAuthor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRevision(tt.id, tt.author, Change{Field: tt.field}); err != tt.expected {
+				t.Errorf("NewRevision() error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// DiffLines Tests
+// ============================================================================
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   string
+		after    string
+		expected string
+	}{
+		{"identical", "a\nb", "a\nb", "  a|  b"},
+		{"edit in middle", "a\nb\nc", "a\nB\nc", "  a|- b|+ B|  c"},
+		{"insert and delete", "a\nb\nc\nd", "a\nc\nd\ne", "  a|- b|  c|  d|+ e"},
+		{"from empty", "", "x\ny", "+ x|+ y"},
+		{"to empty", "x", "", "- x"},
+		{"both empty", "", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, l := range DiffLines(tt.before, tt.after) {
+				got = append(got, l.String())
+			}
+			if s := strings.Join(got, "|"); s != tt.expected {
+				t.Errorf("DiffLines() = %q, want %q", s, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDiffLines_LargeInputFallsBackToBlockReplace(t *testing.T) {
+	before := strings.Repeat("a\n", 2100) + "x"
+	after := strings.Repeat("b\n", 2100) + "x"
+	lines := DiffLines(before, after)
+	if len(lines) != 4201 || lines[0].Op != DiffDelete || lines[2100].Op != DiffInsert || lines[4200].Op != DiffEqual {
+		t.Errorf("unexpected fallback diff of %d lines", len(lines))
+	}
+}
@@ -0,0 +1,204 @@
+package nippou
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// ============================================================================
+// Template - Mandated Report Structure
+// ============================================================================
+
+// MaxTemplateNameLength is the maximum allowed length for a template name.
+const MaxTemplateNameLength = 50
+
+// StandardTemplateName is the name of the built-in company template.
+const StandardTemplateName = "standard"
+
+// Template errors.
+var (
+	ErrEmptyTemplateName    = &DomainError{Code: ErrCodeValidation, Field: "template", Message: "template name cannot be empty"}
+	ErrTemplateNameTooLong  = &DomainError{Code: ErrCodeLimitExceeded, Field: "template", Message: "template name exceeds maximum length"}
+	ErrEmptyTemplate        = &DomainError{Code: ErrCodeValidation, Field: "template.sections", Message: "template must have at least one section"}
+	ErrDuplicateSection     = &DomainError{Code: ErrCodeDuplicate, Field: "template.sections", Message: "section heading already exists"}
+	ErrEmptySectionHeading  = &DomainError{Code: ErrCodeValidation, Field: "template.sections", Message: "section heading cannot be empty"}
+	ErrNegativeSectionLimit = &DomainError{Code: ErrCodeValidation, Field: "template.sections", Message: "minimum length cannot be negative"}
+)
+
+// TemplateSection describes one section a report written with a Template
+// should contain. MinLength counts characters of the section body after
+// surrounding whitespace is trimmed.
+type TemplateSection struct {
+	Heading   string
+	Required  bool
+	MinLength int
+}
+
+// Template is an immutable, named list of report sections.
+type Template struct {
+	name     string
+	sections []TemplateSection
+}
+
+// NewTemplate creates a validated Template.
+// Headings are compared after normalization, so "成果" and "成果：" collide.
+func NewTemplate(name string, sections []TemplateSection) (*Template, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEmptyTemplateName
+	}
+	if utf8.RuneCountInString(name) > MaxTemplateNameLength {
+		return nil, ErrTemplateNameTooLong
+	}
+	if len(sections) == 0 {
+		return nil, ErrEmptyTemplate
+	}
+
+	seen := make(map[string]bool, len(sections))
+	copied := make([]TemplateSection, len(sections))
+	for i, s := range sections {
+		key := normalizeHeading(s.Heading)
+		switch {
+		case key == "":
+			return nil, ErrEmptySectionHeading
+		case seen[key]:
+			return nil, ErrDuplicateSection
+		case s.MinLength < 0:
+			return nil, ErrNegativeSectionLimit
+		}
+		seen[key] = true
+		copied[i] = TemplateSection{Heading: strings.TrimSpace(s.Heading), Required: s.Required, MinLength: s.MinLength}
+	}
+	return &Template{name: name, sections: copied}, nil
+}
+
+// StandardTemplate returns the company-mandated daily report template:
+// 本日の業務, 成果 and 課題 are required, 明日の予定 is optional.
+func StandardTemplate() *Template {
+	t, _ := NewTemplate(StandardTemplateName, []TemplateSection{
+		{Heading: "本日の業務", Required: true, MinLength: 10},
+		{Heading: "成果", Required: true, MinLength: 1},
+		{Heading: "課題", Required: true, MinLength: 1},
+		{Heading: "明日の予定", Required: false},
+	})
+	return t
+}
+
+// Name returns the template name.
+func (t *Template) Name() string {
+	if t == nil {
+		return ""
+	}
+	return t.name
+}
+
+// Sections returns a copy of the template sections in order.
+func (t *Template) Sections() []TemplateSection {
+	if t == nil {
+		return nil
+	}
+	result := make([]TemplateSection, len(t.sections))
+	copy(result, t.sections)
+	return result
+}
+
+// Validate checks that content contains every required section with at
+// least its minimum length. All problems are reported together as
+// ValidationErrors whose Field is "content.<heading>".
+func (t *Template) Validate(content string) error {
+	if t == nil {
+		return nil
+	}
+
+	bodies := make(map[string]string)
+	found := make(map[string]bool)
+	for _, s := range ParseSections(content) {
+		key := normalizeHeading(s.Heading)
+		found[key] = true
+		if bodies[key] != "" && s.Body != "" {
+			bodies[key] += "\n"
+		}
+		bodies[key] += s.Body
+	}
+
+	var errs ValidationErrors
+	for _, s := range t.sections {
+		key := normalizeHeading(s.Heading)
+		field := "content." + s.Heading
+		if !found[key] {
+			if s.Required {
+				errs.Add(&DomainError{Code: ErrCodeValidation, Field: field, Message: "required section is missing"})
+			}
+			continue
+		}
+		if n := utf8.RuneCountInString(bodies[key]); n < s.MinLength {
+			errs.Add(&DomainError{
+				Code:    ErrCodeValidation,
+				Field:   field,
+				Message: fmt.Sprintf("section must be at least %d characters (got %d)", s.MinLength, n),
+			})
+		}
+	}
+	return errs.Err()
+}
+
+// ============================================================================
+// Section Parser
+// ============================================================================
+
+// Section is one headed part of report content. Text before the first
+// heading is returned as a Section with an empty Heading.
+type Section struct {
+	Heading string
+	Body    string
+}
+
+// sectionHeadingPattern matches the heading styles used in reports:
+// Markdown ("## 成果") and lenticular brackets ("【成果】"). Lines starting
+// with ■, □, ◆ or ◇ are bullets and checkboxes in Japanese reports, not
+// headings.
+var sectionHeadingPattern = regexp.MustCompile(`^(?:#{1,6}\s+(.+?)\s*#*|【(.+?)】)\s*$`)
+
+// ParseSections splits content into sections by heading lines. Bodies are
+// trimmed; a leading Section is only returned when it has text.
+func ParseSections(content string) []Section {
+	var sections []Section
+	var heading string
+	var body []string
+	started := false
+
+	flush := func() {
+		text := strings.TrimSpace(strings.Join(body, "\n"))
+		if started || text != "" {
+			sections = append(sections, Section{Heading: heading, Body: text})
+		}
+		body = body[:0]
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		m := sectionHeadingPattern.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			body = append(body, line)
+			continue
+		}
+		flush()
+		heading = strings.TrimSpace(m[1] + m[2])
+		started = true
+	}
+	flush()
+	return sections
+}
+
+// normalizeHeading folds width, applies NFC, lowercases and drops a trailing
+// colon so that headings match regardless of how they were typed.
+func normalizeHeading(heading string) string {
+	s := norm.NFC.String(width.Fold.String(heading))
+	s = strings.TrimSpace(strings.ToLower(s))
+	s = strings.TrimRight(s, ":")
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,124 @@
+package nippou
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// ParseSections Tests
+// ============================================================================
+
+func TestParseSections(t *testing.T) {
+	content := "前置き\n\n## 本日の業務\nA社訪問\n見積提出\n【成果】\n受注\n## 課題：\n\n### 明日の予定 ###\nB社"
+
+	sections := ParseSections(content)
+	want := []Section{
+		{Heading: "", Body: "前置き"},
+		{Heading: "本日の業務", Body: "A社訪問\n見積提出"},
+		{Heading: "成果", Body: "受注"},
+		{Heading: "課題：", Body: ""},
+		{Heading: "明日の予定", Body: "B社"},
+	}
+	if len(sections) != len(want) {
+		t.Fatalf("ParseSections() = %+v, want %+v", sections, want)
+	}
+	for i := range want {
+		if sections[i] != want[i] {
+			t.Errorf("sections[%d] = %+v, want %+v", i, sections[i], want[i])
+		}
+	}
+}
+
+func TestParseSections_BulletsAreNotHeadings(t *testing.T) {
+	content := "## 本日の業務\n■ 訪問先A\n□ 見積提出\n◆ 電話対応\n## 成果\n受注"
+	sections := ParseSections(content)
+	if len(sections) != 2 || sections[0].Body != "■ 訪問先A\n□ 見積提出\n◆ 電話対応" {
+		t.Errorf("ParseSections() = %+v, want the bullets kept in 本日の業務", sections)
+	}
+	if err := StandardTemplate().Validate(content + "\n## 課題\n納期"); err != nil {
+		t.Errorf("Validate() error = %v, want bullets to count toward the section", err)
+	}
+}
+
+func TestParseSections_NoHeadings(t *testing.T) {
+	if got := ParseSections("  \n"); len(got) != 0 {
+		t.Errorf("ParseSections(blank) = %+v, want none", got)
+	}
+	if got := ParseSections("#hashtag is not a heading"); len(got) != 1 || got[0].Heading != "" {
+		t.Errorf("ParseSections() = %+v, want a single body section", got)
+	}
+}
+
+// ============================================================================
+// Template Tests
+// ============================================================================
+
+func TestNewTemplate_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		tmpl     string
+		sections []TemplateSection
+		expected error
+	}{
+		{"empty name", " ", []TemplateSection{{Heading: "a"}}, ErrEmptyTemplateName},
+		{"long name", strings.Repeat("a", MaxTemplateNameLength+1), []TemplateSection{{Heading: "a"}}, ErrTemplateNameTooLong},
+		{"no sections", "x", nil, ErrEmptyTemplate},
+		{"empty heading", "x", []TemplateSection{{Heading: " : "}}, ErrEmptySectionHeading},
+		{"duplicate heading", "x", []TemplateSection{{Heading: "成果"}, {Heading: "成果："}}, ErrDuplicateSection},
+		{"negative minimum", "x", []TemplateSection{{Heading: "a", MinLength: -1}}, ErrNegativeSectionLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTemplate(tt.tmpl, tt.sections); err != tt.expected {
+				t.Errorf("NewTemplate() error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTemplate_Validate(t *testing.T) {
+	tmpl := StandardTemplate()
+
+	valid := "## 本日の業務\nA社訪問と見積書の提出\n## 成果\n受注\n## 課題\n納期調整"
+	if err := tmpl.Validate(valid); err != nil {
+		t.Errorf("Validate(valid) error = %v", err)
+	}
+
+	err := tmpl.Validate("【本日の業務】\n訪問\n【成果】\n受注")
+	if err == nil {
+		t.Fatal("Validate() should fail when sections are missing or short")
+	}
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Validate() error = %T, want ValidationErrors", err)
+	}
+	for _, e := range errs {
+		if e.Code != ErrCodeValidation {
+			t.Errorf("expected validation DomainError, got %v", e)
+		}
+	}
+	if got := strings.Join(errs.Fields(), ","); got != "content.本日の業務,content.課題" {
+		t.Errorf("error fields = %s", got)
+	}
+	if !IsValidationError(err) {
+		t.Error("IsValidationError() should be true for template errors")
+	}
+}
+
+func TestTemplate_Validate_HeadingVariants(t *testing.T) {
+	tmpl, _ := NewTemplate("visit", []TemplateSection{{Heading: "Customer", Required: true, MinLength: 3}})
+	if err := tmpl.Validate("# ＣＵＳＴＯＭＥＲ：\nACME"); err != nil {
+		t.Errorf("Validate() should match width- and case-folded headings: %v", err)
+	}
+}
+
+func TestTemplate_Sections_ReturnsCopy(t *testing.T) {
+	tmpl := StandardTemplate()
+	sections := tmpl.Sections()
+	sections[0].Required = false
+	if !tmpl.Sections()[0].Required {
+		t.Error("Sections() should return a copy")
+	}
+}
@@ -0,0 +1,85 @@
+package nippou
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ============================================================================
+// Text Normalizer - Input Hygiene for User-Supplied Strings
+// ============================================================================
+
+// TextNormalizer cleans user-supplied text before it enters the domain.
+// The pipeline is applied in order:
+//
+//  1. Invalid UTF-8 (including encoded surrogate halves) is rejected, or
+//     replaced with U+FFFD when RepairInvalidUTF8 is set.
+//  2. Byte order marks (U+FEFF) are removed wherever they appear.
+//  3. CRLF and CR line endings become LF.
+//  4. Control characters and bidi embedding/override/isolate characters are
+//     removed. Multiline text keeps LF and tab; single-line text turns them
+//     into spaces.
+//  5. The result is NFC-normalized and trimmed.
+type TextNormalizer struct {
+	Multiline         bool // Keep LF and tab instead of converting them to spaces
+	RepairInvalidUTF8 bool // Replace invalid sequences instead of rejecting them
+}
+
+var (
+	// MultilineText normalizes free text such as report content.
+	MultilineText = TextNormalizer{Multiline: true}
+	// SingleLineText normalizes short fields such as addresses and names.
+	SingleLineText = TextNormalizer{}
+)
+
+// Normalize applies the pipeline to s. When s is not valid UTF-8 it returns
+// ErrInvalidTextEncoding reported against field.
+func (tn TextNormalizer) Normalize(field, s string) (string, error) {
+	if !utf8.ValidString(s) {
+		if !tn.RepairInvalidUTF8 {
+			return "", ErrInvalidTextEncoding.WithField(field)
+		}
+		s = strings.ToValidUTF8(s, string(utf8.RuneError))
+	}
+
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case r == '\r':
+			r = '\n'
+		case isDisallowedRune(r):
+			continue
+		}
+		if !tn.Multiline && (r == '\n' || r == '\t') {
+			r = ' '
+		}
+		sb.WriteRune(r)
+	}
+
+	return strings.TrimSpace(norm.NFC.String(sb.String())), nil
+}
+
+// isDisallowedRune reports whether r is removed from all text: control
+// characters other than LF and tab, the BOM, and bidi formatting characters
+// that can visually reorder text (Trojan Source).
+func isDisallowedRune(r rune) bool {
+	switch {
+	case r == '\n' || r == '\t':
+		return false
+	case unicode.IsControl(r):
+		return true
+	case r == '\uFEFF':
+		return true
+	case r >= '\u202A' && r <= '\u202E': // LRE, RLE, PDF, LRO, RLO
+		return true
+	case r >= '\u2066' && r <= '\u2069': // LRI, RLI, FSI, PDI
+		return true
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package nippou
+
+import (
+	"errors"
+	"strings"
+)
+
+// ============================================================================
+// ValidationErrors - All Problems of an Input at Once
+// ============================================================================
+
+// ValidationErrors collects every DomainError found while validating an
+// input, so a client can fix all fields in one round trip. errors.Is and
+// errors.As see each entry: errors.Is(err, ErrEmptyContent) holds when any
+// entry is (a relabeled copy of) ErrEmptyContent.
+type ValidationErrors []*DomainError
+
+// Error joins the entries in the order they were found.
+func (v ValidationErrors) Error() string {
+	parts := make([]string, len(v))
+	for i, e := range v {
+		parts[i] = e.Error()
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Unwrap returns the entries for errors.Is and errors.As.
+func (v ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(v))
+	for i, e := range v {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Add appends err. Nested ValidationErrors are flattened; errors that are not
+// DomainErrors are recorded as validation errors with their message.
+func (v *ValidationErrors) Add(err error) {
+	if err == nil {
+		return
+	}
+	var list ValidationErrors
+	if errors.As(err, &list) {
+		*v = append(*v, list...)
+		return
+	}
+	var domErr *DomainError
+	if errors.As(err, &domErr) {
+		*v = append(*v, domErr)
+		return
+	}
+	*v = append(*v, &DomainError{Code: ErrCodeValidation, Message: err.Error()})
+}
+
+// Err returns v as an error, or nil when nothing was collected.
+func (v ValidationErrors) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return v
+}
+
+// Fields returns the fields at fault, in order and without duplicates.
+func (v ValidationErrors) Fields() []string {
+	seen := make(map[string]bool, len(v))
+	fields := make([]string, 0, len(v))
+	for _, e := range v {
+		if e.Field != "" && !seen[e.Field] {
+			seen[e.Field] = true
+			fields = append(fields, e.Field)
+		}
+	}
+	return fields
+}
+
+// WithField returns a copy of e reported against field, such as an input
+// path like "location.latitude". The copy still matches e with errors.Is.
+func (e *DomainError) WithField(field string) *DomainError {
+	c := *e
+	c.Field = field
+	return &c
+}
+
+// Is reports whether target is a DomainError with the same code and
+// message, so sentinels match their relabeled copies.
+func (e *DomainError) Is(target error) bool {
+	t, ok := target.(*DomainError)
+	return ok && e.Code == t.Code && e.Message == t.Message
+}
@@ -0,0 +1,74 @@
+package nippou
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// ValidationErrors Tests
+// ============================================================================
+
+func TestNippouBuilder_Build_CollectsAllErrors(t *testing.T) {
+	tags := make([]Tag, MaxTagCount+1)
+	for i := range tags {
+		tags[i], _ = NewTag(fmt.Sprintf("tag%d", i))
+	}
+	_, err := NewNippouBuilder("2026/01/08", "  ").WithTags(tags).Build()
+
+	var list ValidationErrors
+	if !errors.As(err, &list) {
+		t.Fatalf("Build() error = %T, want ValidationErrors", err)
+	}
+	if got := strings.Join(list.Fields(), ","); got != "content,date,tags" {
+		t.Errorf("Fields() = %s, want content,date,tags", got)
+	}
+	for _, sentinel := range []error{ErrEmptyContent, ErrInvalidDateFormat, ErrMaxTagsExceeded} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("errors.Is(err, %v) = false", sentinel)
+		}
+	}
+	var first *DomainError
+	if !errors.As(err, &first) || first.Field != "content" {
+		t.Errorf("errors.As(*DomainError) = %v, want the content error", first)
+	}
+	if !IsValidationError(err) {
+		t.Error("IsValidationError() = false")
+	}
+}
+
+func TestValidationErrors_Add(t *testing.T) {
+	var errs ValidationErrors
+	if errs.Err() != nil {
+		t.Error("Err() of an empty list should be nil")
+	}
+
+	errs.Add(nil)
+	errs.Add(ErrEmptyContent)
+	errs.Add(ValidationErrors{ErrInvalidLatitude, ErrInvalidLongitude})
+	errs.Add(errors.New("template section missing"))
+	if len(errs) != 4 {
+		t.Fatalf("len = %d, want 4 (nil skipped, nested list flattened)", len(errs))
+	}
+	if errs[3].Code != ErrCodeValidation || errs[3].Message != "template section missing" {
+		t.Errorf("plain error recorded as %+v", errs[3])
+	}
+	if !strings.Contains(errs.Err().Error(), "content cannot be empty; VALIDATION_ERROR: latitude") {
+		t.Errorf("Error() = %q", errs.Err().Error())
+	}
+}
+
+func TestDomainError_WithField(t *testing.T) {
+	relabeled := ErrTagTooLong.WithField("tags[2]")
+	if relabeled.Field != "tags[2]" || ErrTagTooLong.Field != "tag" {
+		t.Errorf("WithField() = %+v, sentinel = %+v", relabeled, ErrTagTooLong)
+	}
+	if !errors.Is(relabeled, ErrTagTooLong) {
+		t.Error("relabeled copy should match its sentinel")
+	}
+	if errors.Is(relabeled, ErrEmptyTag) {
+		t.Error("relabeled copy should not match other sentinels")
+	}
+}
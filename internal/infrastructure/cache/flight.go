@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"sync"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// flightGroup - Duplicate Call Suppression (singleflight style)
+// ============================================================================
+
+// flightCall is an in-flight or completed fetch shared by concurrent callers.
+type flightCall struct {
+	wg    sync.WaitGroup
+	value []*nippou.Nippou
+	err   error
+}
+
+// flightGroup collapses concurrent calls with the same key into one execution.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// do executes fn once per key at a time; duplicate callers wait for and share
+// the result of the call already in flight.
+func (g *flightGroup) do(key string, fn func() ([]*nippou.Nippou, error)) ([]*nippou.Nippou, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		call.wg.Wait()
+		return call.value, call.err
+	}
+	call := &flightCall{}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		call.wg.Done()
+	}()
+
+	call.value, call.err = fn()
+	return call.value, call.err
+}
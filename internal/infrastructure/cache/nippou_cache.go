@@ -0,0 +1,389 @@
+package cache
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Cache Errors
+// ============================================================================
+
+// ErrUnsupported is returned when the decorated repository lacks an optional query.
+var ErrUnsupported = errors.New("query not supported by underlying repository")
+
+// ============================================================================
+// Cache Configuration
+// ============================================================================
+
+// Config holds configuration for the read-through Nippou cache.
+type Config struct {
+	TTL        time.Duration    // Lifetime of a cached query result
+	MaxEntries int              // LRU bound; least recently used entries are evicted first
+	Now        func() time.Time // Clock (useful for testing)
+}
+
+// DefaultConfig returns sensible default cache configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		TTL:        5 * time.Minute,
+		MaxEntries: 1000,
+		Now:        time.Now,
+	}
+}
+
+// Stats is a snapshot of cache counters.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+}
+
+// HitRatio returns hits / (hits + misses), or 0 when nothing was looked up.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// ============================================================================
+// CachedNippouRepository - Read-Through Decorator for nippou.Repository
+// ============================================================================
+
+// CachedNippouRepository decorates a nippou.Repository with a TTL/LRU
+// read-through cache. Concurrent identical queries are collapsed into a single
+// call to the underlying repository, and Save/Delete invalidate affected
+// entries after the write succeeds.
+type CachedNippouRepository struct {
+	next   nippou.Repository
+	config *Config
+
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	lru        *list.List
+	generation uint64
+
+	flights flightGroup
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+// cacheEntry is a single cached query result stored in the LRU list.
+type cacheEntry struct {
+	key       string
+	value     []*nippou.Nippou
+	expiresAt time.Time
+}
+
+// NewCachedNippouRepository wraps next with a read-through cache.
+// A nil config uses DefaultConfig.
+func NewCachedNippouRepository(next nippou.Repository, config *Config) *CachedNippouRepository {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &CachedNippouRepository{
+		next:    next,
+		config:  config,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Stats returns a snapshot of the hit/miss/eviction counters.
+func (c *CachedNippouRepository) Stats() Stats {
+	c.mu.Lock()
+	entries := c.lru.Len()
+	c.mu.Unlock()
+	return Stats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Entries:   entries,
+	}
+}
+
+// Purge drops every cached entry.
+func (c *CachedNippouRepository) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+// ============================================================================
+// Reader Interface Implementation
+// ============================================================================
+
+// FindByID retrieves a Nippou by ID, serving from cache when possible.
+// Not-found results are cached as well until the next write.
+func (c *CachedNippouRepository) FindByID(id nippou.ID) (*nippou.Nippou, error) {
+	result, err := c.load(idKey(id), func() ([]*nippou.Nippou, error) {
+		n, err := c.next.FindByID(id)
+		if err != nil {
+			return nil, err
+		}
+		if n == nil {
+			return []*nippou.Nippou{}, nil
+		}
+		return []*nippou.Nippou{n}, nil
+	})
+	if err != nil || len(result) == 0 {
+		return nil, err
+	}
+	return result[0], nil
+}
+
+// FindByDate retrieves all Nippou entries for a date, serving from cache when possible.
+func (c *CachedNippouRepository) FindByDate(date nippou.CivilDate) ([]*nippou.Nippou, error) {
+	key := "date:" + date.String()
+	return c.load(key, func() ([]*nippou.Nippou, error) {
+		return c.next.FindByDate(date)
+	})
+}
+
+// FindByDateRange retrieves Nippou entries within a date range.
+// Returns ErrUnsupported if the underlying repository has no range query.
+func (c *CachedNippouRepository) FindByDateRange(startDate, endDate nippou.CivilDate) ([]*nippou.Nippou, error) {
+	ranger, ok := c.next.(nippou.DateRangeReader)
+	if !ok {
+		return nil, fmt.Errorf("FindByDateRange: %w", ErrUnsupported)
+	}
+	key := "range:" + startDate.String() + ":" + endDate.String()
+	return c.load(key, func() ([]*nippou.Nippou, error) {
+		return ranger.FindByDateRange(startDate, endDate)
+	})
+}
+
+// FindByTag retrieves Nippou entries with the given tag.
+// Returns ErrUnsupported if the underlying repository has no tag query.
+func (c *CachedNippouRepository) FindByTag(tag string) ([]*nippou.Nippou, error) {
+	tagger, ok := c.next.(nippou.TagReader)
+	if !ok {
+		return nil, fmt.Errorf("FindByTag: %w", ErrUnsupported)
+	}
+	return c.load("tag:"+tag, func() ([]*nippou.Nippou, error) {
+		return tagger.FindByTag(tag)
+	})
+}
+
+// ============================================================================
+// Writer Interface Implementation (Write-Through Invalidation)
+// ============================================================================
+
+// Save persists the Nippou and invalidates cached entries it may affect.
+func (c *CachedNippouRepository) Save(n *nippou.Nippou) error {
+	if err := c.next.Save(n); err != nil {
+		return err
+	}
+	c.invalidate(n.ID())
+	return nil
+}
+
+// Delete removes the Nippou and invalidates cached entries it may affect.
+func (c *CachedNippouRepository) Delete(id nippou.ID) error {
+	if err := c.next.Delete(id); err != nil {
+		return err
+	}
+	c.invalidate(id)
+	return nil
+}
+
+// invalidate drops the entry for id and every collection query, since a write
+// can move a report into or out of any date, range or tag result.
+func (c *CachedNippouRepository) invalidate(id nippou.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	target := idKey(id)
+	for key, elem := range c.entries {
+		if key == target || !isIDKey(key) {
+			c.lru.Remove(elem)
+			delete(c.entries, key)
+		}
+	}
+}
+
+// ============================================================================
+// Internal Cache Mechanics
+// ============================================================================
+
+// load returns a cached result for key or runs fetch exactly once across
+// concurrent callers, caching its result on success.
+func (c *CachedNippouRepository) load(key string, fetch func() ([]*nippou.Nippou, error)) ([]*nippou.Nippou, error) {
+	if value, ok := c.get(key); ok {
+		if clones, err := cloneAll(value); err == nil {
+			c.hits.Add(1)
+			return clones, nil
+		}
+		// An entry that cannot be copied is never handed out; refetch it
+		c.evict(key)
+	}
+	c.misses.Add(1)
+
+	fetched := false
+	value, err := c.flights.do(key, func() ([]*nippou.Nippou, error) {
+		fetched = true
+		c.mu.Lock()
+		gen := c.generation
+		c.mu.Unlock()
+
+		value, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		c.set(key, value, gen)
+		return value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Callers sharing the flight get their own copies
+	if clones, err := cloneAll(value); err == nil {
+		return clones, nil
+	}
+	// The result cannot be copied, so it was not cached. The caller that
+	// fetched it may keep it; the others read from the repository themselves
+	// rather than share it.
+	if fetched {
+		return value, nil
+	}
+	return fetch()
+}
+
+// evict drops the entry for key.
+func (c *CachedNippouRepository) evict(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+	}
+}
+
+// get returns a live entry and marks it as most recently used.
+func (c *CachedNippouRepository) get(key string) ([]*nippou.Nippou, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !c.config.Now().Before(entry.expiresAt) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return entry.value, true
+}
+
+// set stores a copy of value unless a write invalidated the cache since gen
+// was read, evicting the least recently used entries beyond MaxEntries.
+// Values that cannot be copied are not cached.
+func (c *CachedNippouRepository) set(key string, value []*nippou.Nippou, gen uint64) {
+	clones, err := cloneAll(value)
+	if err != nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.generation {
+		return
+	}
+	entry := &cacheEntry{
+		key:       key,
+		value:     clones,
+		expiresAt: c.config.Now().Add(c.config.TTL),
+	}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.config.MaxEntries > 0 && c.lru.Len() > c.config.MaxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		c.evictions.Add(1)
+	}
+}
+
+// idKey returns the cache key for a FindByID lookup.
+func idKey(id nippou.ID) string {
+	return "id:" + id.String()
+}
+
+// isIDKey reports whether key belongs to a FindByID lookup.
+func isIDKey(key string) bool {
+	return strings.HasPrefix(key, "id:")
+}
+
+// ============================================================================
+// Defensive Copies
+// ============================================================================
+
+// cloneAll deep-copies the entities so callers cannot mutate cached state.
+// It fails rather than return a shared pointer.
+func cloneAll(src []*nippou.Nippou) ([]*nippou.Nippou, error) {
+	if src == nil {
+		return nil, nil
+	}
+	result := make([]*nippou.Nippou, 0, len(src))
+	for _, n := range src {
+		if n == nil {
+			continue
+		}
+		clone, err := cloneNippou(n)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, clone)
+	}
+	return result, nil
+}
+
+// cloneNippou rebuilds an independent copy of n via nippou.Reconstruct.
+func cloneNippou(n *nippou.Nippou) (*nippou.Nippou, error) {
+	clone, err := nippou.Reconstruct(nippou.ReconstructedNippou{
+		ID:        n.ID().String(),
+		Date:      n.Date(),
+		Content:   n.Content(),
+		Location:  n.Location(),
+		Voice:     n.Voice(),
+		Tags:      n.TagStrings(),
+		CreatedAt: n.CreatedAt(),
+		UpdatedAt: n.UpdatedAt(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("copy cached nippou %s: %w", n.ID(), err)
+	}
+	return clone, nil
+}
+
+// ============================================================================
+// Compile-time Interface Compliance Check
+// ============================================================================
+
+var (
+	_ nippou.Repository      = (*CachedNippouRepository)(nil)
+	_ nippou.DateRangeReader = (*CachedNippouRepository)(nil)
+	_ nippou.TagReader       = (*CachedNippouRepository)(nil)
+)
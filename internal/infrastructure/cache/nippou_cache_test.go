@@ -0,0 +1,436 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Test Doubles
+// ============================================================================
+
+// MockRepository is a test double implementing nippou.Repository and the
+// optional range/tag queries. Calls are counted atomically.
+type MockRepository struct {
+	FindByIDFunc        func(id nippou.ID) (*nippou.Nippou, error)
+	FindByDateFunc      func(date nippou.CivilDate) ([]*nippou.Nippou, error)
+	FindByDateRangeFunc func(start, end nippou.CivilDate) ([]*nippou.Nippou, error)
+	FindByTagFunc       func(tag string) ([]*nippou.Nippou, error)
+	SaveFunc            func(n *nippou.Nippou) error
+
+	Calls atomic.Int64
+}
+
+func (m *MockRepository) FindByID(id nippou.ID) (*nippou.Nippou, error) {
+	m.Calls.Add(1)
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(id)
+	}
+	return nil, nil
+}
+
+func (m *MockRepository) FindByDate(date nippou.CivilDate) ([]*nippou.Nippou, error) {
+	m.Calls.Add(1)
+	if m.FindByDateFunc != nil {
+		return m.FindByDateFunc(date)
+	}
+	return nil, nil
+}
+
+func (m *MockRepository) FindByDateRange(start, end nippou.CivilDate) ([]*nippou.Nippou, error) {
+	m.Calls.Add(1)
+	if m.FindByDateRangeFunc != nil {
+		return m.FindByDateRangeFunc(start, end)
+	}
+	return nil, nil
+}
+
+func (m *MockRepository) FindByTag(tag string) ([]*nippou.Nippou, error) {
+	m.Calls.Add(1)
+	if m.FindByTagFunc != nil {
+		return m.FindByTagFunc(tag)
+	}
+	return nil, nil
+}
+
+func (m *MockRepository) Save(n *nippou.Nippou) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(n)
+	}
+	return nil
+}
+
+func (m *MockRepository) Delete(id nippou.ID) error {
+	return nil
+}
+
+// basicRepository only implements nippou.Repository.
+type basicRepository struct {
+	nippou.Repository
+}
+
+// ============================================================================
+// Test Helpers
+// ============================================================================
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)}
+}
+
+func mustNippou(t *testing.T, content string) *nippou.Nippou {
+	t.Helper()
+	n, err := nippou.NewNippou("2024-01-15", content)
+	if err != nil {
+		t.Fatalf("failed to create Nippou: %v", err)
+	}
+	return n
+}
+
+func newTestCache(repo nippou.Repository, clock *fakeClock, maxEntries int) *CachedNippouRepository {
+	return NewCachedNippouRepository(repo, &Config{
+		TTL:        time.Minute,
+		MaxEntries: maxEntries,
+		Now:        clock.Now,
+	})
+}
+
+var testDate, _ = nippou.ParseCivilDate("2024-01-15")
+
+// ============================================================================
+// Read-Through Tests
+// ============================================================================
+
+func TestCache_FindByDate_HitAfterMiss(t *testing.T) {
+	n := mustNippou(t, "cached content")
+	repo := &MockRepository{
+		FindByDateFunc: func(date nippou.CivilDate) ([]*nippou.Nippou, error) {
+			return []*nippou.Nippou{n}, nil
+		},
+	}
+	c := newTestCache(repo, newClock(), 10)
+
+	for i := 0; i < 3; i++ {
+		results, err := c.FindByDate(testDate)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(results) != 1 || results[0].Content() != "cached content" {
+			t.Fatalf("unexpected results: %v", results)
+		}
+	}
+
+	if got := repo.Calls.Load(); got != 1 {
+		t.Errorf("expected 1 underlying call, got %d", got)
+	}
+	stats := c.Stats()
+	if stats.Hits != 2 || stats.Misses != 1 {
+		t.Errorf("expected 2 hits / 1 miss, got %+v", stats)
+	}
+	if ratio := stats.HitRatio(); ratio < 0.66 || ratio > 0.67 {
+		t.Errorf("unexpected hit ratio: %f", ratio)
+	}
+}
+
+func TestCache_FindByID_CachesNotFound(t *testing.T) {
+	repo := &MockRepository{}
+	c := newTestCache(repo, newClock(), 10)
+	id := nippou.NewID()
+
+	for i := 0; i < 2; i++ {
+		n, err := c.FindByID(id)
+		if err != nil || n != nil {
+			t.Fatalf("expected (nil, nil), got (%v, %v)", n, err)
+		}
+	}
+	if got := repo.Calls.Load(); got != 1 {
+		t.Errorf("expected 1 underlying call, got %d", got)
+	}
+}
+
+func TestCache_ErrorsAreNotCached(t *testing.T) {
+	repoErr := errors.New("api down")
+	repo := &MockRepository{
+		FindByTagFunc: func(tag string) ([]*nippou.Nippou, error) {
+			return nil, repoErr
+		},
+	}
+	c := newTestCache(repo, newClock(), 10)
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.FindByTag("sales"); !errors.Is(err, repoErr) {
+			t.Fatalf("expected repository error, got %v", err)
+		}
+	}
+	if got := repo.Calls.Load(); got != 2 {
+		t.Errorf("expected 2 underlying calls, got %d", got)
+	}
+}
+
+func TestCache_TTLExpiry(t *testing.T) {
+	repo := &MockRepository{}
+	clock := newClock()
+	c := newTestCache(repo, clock, 10)
+
+	_, _ = c.FindByDateRange(testDate, testDate.AddDays(6))
+	clock.Advance(59 * time.Second)
+	_, _ = c.FindByDateRange(testDate, testDate.AddDays(6))
+	if got := repo.Calls.Load(); got != 1 {
+		t.Fatalf("expected entry to be live before TTL, got %d calls", got)
+	}
+
+	clock.Advance(time.Second)
+	_, _ = c.FindByDateRange(testDate, testDate.AddDays(6))
+	if got := repo.Calls.Load(); got != 2 {
+		t.Errorf("expected refetch after TTL, got %d calls", got)
+	}
+}
+
+func TestCache_LRUEviction(t *testing.T) {
+	repo := &MockRepository{}
+	c := newTestCache(repo, newClock(), 2)
+
+	_, _ = c.FindByTag("a")
+	_, _ = c.FindByTag("b")
+	_, _ = c.FindByTag("a") // a becomes most recently used
+	_, _ = c.FindByTag("c") // evicts b
+
+	if stats := c.Stats(); stats.Evictions != 1 || stats.Entries != 2 {
+		t.Fatalf("expected 1 eviction and 2 entries, got %+v", stats)
+	}
+
+	before := repo.Calls.Load()
+	_, _ = c.FindByTag("a")
+	if repo.Calls.Load() != before {
+		t.Error("expected a to remain cached")
+	}
+	_, _ = c.FindByTag("b")
+	if repo.Calls.Load() != before+1 {
+		t.Error("expected b to have been evicted")
+	}
+}
+
+func TestCache_ReturnsDefensiveCopies(t *testing.T) {
+	n := mustNippou(t, "original")
+	repo := &MockRepository{
+		FindByIDFunc: func(id nippou.ID) (*nippou.Nippou, error) {
+			return n, nil
+		},
+	}
+	c := newTestCache(repo, newClock(), 10)
+
+	first, _ := c.FindByID(n.ID())
+	if err := first.UpdateContent("mutated by caller"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	second, _ := c.FindByID(n.ID())
+	if second.Content() != "original" {
+		t.Errorf("cached entity was mutated: %q", second.Content())
+	}
+}
+
+func TestCache_UncopyableResultIsServedUncached(t *testing.T) {
+	repo := &MockRepository{
+		FindByDateFunc: func(date nippou.CivilDate) ([]*nippou.Nippou, error) {
+			return []*nippou.Nippou{{}}, nil // No ID: Reconstruct cannot copy it
+		},
+	}
+	c := newTestCache(repo, newClock(), 10)
+	date, _ := nippou.ParseCivilDate("2024-01-15")
+
+	first, err := c.FindByDate(date)
+	if err != nil || len(first) != 1 {
+		t.Fatalf("FindByDate() = %v, %v; want the repository's result", first, err)
+	}
+	second, err := c.FindByDate(date)
+	if err != nil || len(second) != 1 {
+		t.Fatalf("FindByDate() = %v, %v; want the repository's result", second, err)
+	}
+	if first[0] == second[0] {
+		t.Error("FindByDate() handed out the same entity twice")
+	}
+	if got := repo.Calls.Load(); got != 2 {
+		t.Errorf("expected 2 underlying calls, got %d; uncopyable results must not be cached", got)
+	}
+	if stats := c.Stats(); stats.Hits != 0 {
+		t.Errorf("Hits = %d, want 0", stats.Hits)
+	}
+}
+
+// ============================================================================
+// Invalidation Tests
+// ============================================================================
+
+func TestCache_SaveInvalidatesCollectionsAndID(t *testing.T) {
+	saved := mustNippou(t, "saved")
+	other := mustNippou(t, "other")
+	repo := &MockRepository{
+		FindByIDFunc: func(id nippou.ID) (*nippou.Nippou, error) {
+			if id.Equals(saved.ID()) {
+				return saved, nil
+			}
+			return other, nil
+		},
+	}
+	c := newTestCache(repo, newClock(), 10)
+
+	_, _ = c.FindByID(saved.ID())
+	_, _ = c.FindByID(other.ID())
+	_, _ = c.FindByDate(testDate)
+	_, _ = c.FindByTag("sales")
+
+	if err := c.Save(saved); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	before := repo.Calls.Load()
+	_, _ = c.FindByID(other.ID())
+	if repo.Calls.Load() != before {
+		t.Error("expected unrelated ID entry to survive Save")
+	}
+	_, _ = c.FindByID(saved.ID())
+	_, _ = c.FindByDate(testDate)
+	_, _ = c.FindByTag("sales")
+	if got := repo.Calls.Load() - before; got != 3 {
+		t.Errorf("expected 3 refetches after Save, got %d", got)
+	}
+}
+
+func TestCache_FailedSaveKeepsEntries(t *testing.T) {
+	repo := &MockRepository{
+		SaveFunc: func(n *nippou.Nippou) error { return errors.New("save failed") },
+	}
+	c := newTestCache(repo, newClock(), 10)
+
+	_, _ = c.FindByDate(testDate)
+	if err := c.Save(mustNippou(t, "content")); err == nil {
+		t.Fatal("expected save error")
+	}
+	_, _ = c.FindByDate(testDate)
+	if got := repo.Calls.Load(); got != 1 {
+		t.Errorf("expected entry to survive failed Save, got %d calls", got)
+	}
+}
+
+func TestCache_DeleteInvalidates(t *testing.T) {
+	n := mustNippou(t, "content")
+	repo := &MockRepository{
+		FindByIDFunc: func(id nippou.ID) (*nippou.Nippou, error) { return n, nil },
+	}
+	c := newTestCache(repo, newClock(), 10)
+
+	_, _ = c.FindByID(n.ID())
+	if err := c.Delete(n.ID()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, _ = c.FindByID(n.ID())
+	if got := repo.Calls.Load(); got != 2 {
+		t.Errorf("expected refetch after Delete, got %d calls", got)
+	}
+}
+
+// ============================================================================
+// Concurrency Tests
+// ============================================================================
+
+func TestCache_CollapsesConcurrentQueries(t *testing.T) {
+	release := make(chan struct{})
+	repo := &MockRepository{
+		FindByDateRangeFunc: func(start, end nippou.CivilDate) ([]*nippou.Nippou, error) {
+			<-release
+			return []*nippou.Nippou{}, nil
+		},
+	}
+	c := newTestCache(repo, newClock(), 10)
+
+	const callers = 20
+	var started, done sync.WaitGroup
+	started.Add(callers)
+	done.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer done.Done()
+			started.Done()
+			if _, err := c.FindByDateRange(testDate, testDate); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	started.Wait()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	done.Wait()
+
+	if got := repo.Calls.Load(); got != 1 {
+		t.Errorf("expected concurrent queries to collapse into 1 call, got %d", got)
+	}
+}
+
+func TestCache_InFlightResultDiscardedAfterWrite(t *testing.T) {
+	release := make(chan struct{})
+	entered := make(chan struct{})
+	var once sync.Once
+	repo := &MockRepository{
+		FindByDateFunc: func(date nippou.CivilDate) ([]*nippou.Nippou, error) {
+			once.Do(func() {
+				close(entered)
+				<-release
+			})
+			return nil, nil
+		},
+	}
+	c := newTestCache(repo, newClock(), 10)
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = c.FindByDate(testDate)
+		close(done)
+	}()
+	<-entered
+	_ = c.Save(mustNippou(t, "written during fetch"))
+	close(release)
+	<-done
+
+	_, _ = c.FindByDate(testDate)
+	if got := repo.Calls.Load(); got != 2 {
+		t.Errorf("expected stale in-flight result to be discarded, got %d calls", got)
+	}
+}
+
+// ============================================================================
+// Optional Query Tests
+// ============================================================================
+
+func TestCache_UnsupportedOptionalQueries(t *testing.T) {
+	c := NewCachedNippouRepository(basicRepository{}, nil)
+
+	if _, err := c.FindByDateRange(testDate, testDate); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("expected ErrUnsupported, got %v", err)
+	}
+	if _, err := c.FindByTag("sales"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("expected ErrUnsupported, got %v", err)
+	}
+}
@@ -0,0 +1,268 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Dispatcher Errors
+// ============================================================================
+
+// ErrDispatcherClosed is reported for events dispatched after Close.
+var ErrDispatcherClosed = errors.New("event dispatcher is closed")
+
+// ErrorHandler receives handler failures. Dispatching never fails the caller,
+// because the events describe changes that are already saved.
+type ErrorHandler func(e nippou.Event, err error)
+
+// ============================================================================
+// registry - Handler Subscriptions
+// ============================================================================
+
+// subscription is a handler and the event names it wants (all when empty).
+type subscription struct {
+	names   map[string]bool
+	handler nippou.EventHandler
+}
+
+// registry holds subscriptions and delivers events to them.
+type registry struct {
+	mu      sync.RWMutex
+	subs    []subscription
+	onError ErrorHandler
+}
+
+// subscribe registers h for the named events, or for every event when no
+// names are given.
+func (r *registry) subscribe(h nippou.EventHandler, names ...string) {
+	if h == nil {
+		return
+	}
+	sub := subscription{handler: h}
+	if len(names) > 0 {
+		sub.names = make(map[string]bool, len(names))
+		for _, name := range names {
+			sub.names[name] = true
+		}
+	}
+	r.mu.Lock()
+	r.subs = append(r.subs, sub)
+	r.mu.Unlock()
+}
+
+// setErrorHandler replaces the error handler.
+func (r *registry) setErrorHandler(fn ErrorHandler) {
+	r.mu.Lock()
+	r.onError = fn
+	r.mu.Unlock()
+}
+
+// deliver calls every matching handler in subscription order. A failing or
+// panicking handler is reported and does not stop the others.
+func (r *registry) deliver(ctx context.Context, e nippou.Event) {
+	r.mu.RLock()
+	subs := r.subs
+	onError := r.onError
+	r.mu.RUnlock()
+
+	for _, sub := range subs {
+		if sub.names != nil && !sub.names[e.EventName()] {
+			continue
+		}
+		if err := call(ctx, sub.handler, e); err != nil && onError != nil {
+			onError(e, err)
+		}
+	}
+}
+
+// report passes err for e to the error handler, if any.
+func (r *registry) report(e nippou.Event, err error) {
+	r.mu.RLock()
+	onError := r.onError
+	r.mu.RUnlock()
+	if onError != nil {
+		onError(e, err)
+	}
+}
+
+// call runs h, converting a panic into an error.
+func call(ctx context.Context, h nippou.EventHandler, e nippou.Event) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("event handler panicked on %s: %v", e.EventName(), p)
+		}
+	}()
+	return h(ctx, e)
+}
+
+// ============================================================================
+// SyncDispatcher - Delivers Events on the Caller's Goroutine
+// ============================================================================
+
+// SyncDispatcher delivers events before Dispatch returns, in order.
+type SyncDispatcher struct {
+	registry
+}
+
+// NewSyncDispatcher creates a SyncDispatcher without subscribers.
+func NewSyncDispatcher() *SyncDispatcher {
+	return &SyncDispatcher{}
+}
+
+// Subscribe registers h for the named events, or for every event when no
+// names are given.
+func (d *SyncDispatcher) Subscribe(h nippou.EventHandler, names ...string) *SyncDispatcher {
+	d.subscribe(h, names...)
+	return d
+}
+
+// WithErrorHandler sets the handler for handler failures.
+func (d *SyncDispatcher) WithErrorHandler(fn ErrorHandler) *SyncDispatcher {
+	d.setErrorHandler(fn)
+	return d
+}
+
+// Dispatch delivers events to the subscribed handlers.
+func (d *SyncDispatcher) Dispatch(ctx context.Context, events []nippou.Event) {
+	for _, e := range events {
+		if e != nil {
+			d.deliver(ctx, e)
+		}
+	}
+}
+
+// ============================================================================
+// AsyncDispatcher - Delivers Events on a Background Worker
+// ============================================================================
+
+// DefaultQueueSize is the AsyncDispatcher buffer used when none is given.
+const DefaultQueueSize = 256
+
+// queued is an event waiting for delivery with the context it was sent with.
+type queued struct {
+	ctx   context.Context
+	event nippou.Event
+}
+
+// AsyncDispatcher queues events and delivers them on a single background
+// worker, so handlers see events in dispatch order. Handlers receive the
+// dispatching context's values but not its cancellation.
+type AsyncDispatcher struct {
+	registry
+	queue   chan queued
+	closing chan struct{}  // Closed by Close to release blocked senders
+	done    chan struct{}  // Closed when the worker has finished
+	sending sync.WaitGroup // Dispatch calls that may still send to queue
+	mu      sync.RWMutex   // guards closed
+	closed  bool
+}
+
+// NewAsyncDispatcher starts a dispatcher whose queue holds size events.
+// Dispatch blocks while the queue is full. Call Close to stop the worker.
+func NewAsyncDispatcher(size int) *AsyncDispatcher {
+	if size <= 0 {
+		size = DefaultQueueSize
+	}
+	d := &AsyncDispatcher{
+		queue:   make(chan queued, size),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go d.run()
+	return d
+}
+
+// Subscribe registers h for the named events, or for every event when no
+// names are given.
+func (d *AsyncDispatcher) Subscribe(h nippou.EventHandler, names ...string) *AsyncDispatcher {
+	d.subscribe(h, names...)
+	return d
+}
+
+// WithErrorHandler sets the handler for handler failures and dropped events.
+// It is called from the worker goroutine.
+func (d *AsyncDispatcher) WithErrorHandler(fn ErrorHandler) *AsyncDispatcher {
+	d.setErrorHandler(fn)
+	return d
+}
+
+// Dispatch queues events for delivery. Events that cannot be queued because
+// the dispatcher is closed or ctx is done are reported to the error handler.
+// No lock is held while waiting for queue space, so a handler may dispatch
+// again while Close is in progress.
+func (d *AsyncDispatcher) Dispatch(ctx context.Context, events []nippou.Event) {
+	d.mu.RLock()
+	if d.closed {
+		d.mu.RUnlock()
+		d.drop(events, ErrDispatcherClosed)
+		return
+	}
+	d.sending.Add(1)
+	d.mu.RUnlock()
+	defer d.sending.Done()
+
+	detached := context.WithoutCancel(ctx)
+	for i, e := range events {
+		if e == nil {
+			continue
+		}
+		select {
+		case d.queue <- queued{ctx: detached, event: e}:
+		case <-ctx.Done():
+			d.drop(events[i:], ctx.Err())
+			return
+		case <-d.closing:
+			d.drop(events[i:], ErrDispatcherClosed)
+			return
+		}
+	}
+}
+
+// drop reports events that were not queued.
+func (d *AsyncDispatcher) drop(events []nippou.Event, err error) {
+	for _, e := range events {
+		if e != nil {
+			d.report(e, err)
+		}
+	}
+}
+
+// Close stops accepting events, delivers those already queued and waits for
+// the worker to finish. It is safe to call more than once.
+func (d *AsyncDispatcher) Close() {
+	d.mu.Lock()
+	first := !d.closed
+	d.closed = true
+	d.mu.Unlock()
+
+	if first {
+		close(d.closing)
+		// Senders give up once closing is closed; only then is the queue
+		// closed, so no send can hit a closed channel
+		d.sending.Wait()
+		close(d.queue)
+	}
+	<-d.done
+}
+
+// run delivers queued events until the queue is closed and drained.
+func (d *AsyncDispatcher) run() {
+	defer close(d.done)
+	for item := range d.queue {
+		d.deliver(item.ctx, item.event)
+	}
+}
+
+// ============================================================================
+// Compile-time Interface Compliance Check
+// ============================================================================
+
+var (
+	_ nippou.EventDispatcher = (*SyncDispatcher)(nil)
+	_ nippou.EventDispatcher = (*AsyncDispatcher)(nil)
+)
@@ -0,0 +1,208 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// recorder collects handled event names; safe for concurrent use.
+type recorder struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (r *recorder) handle(ctx context.Context, e nippou.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.names = append(r.names, e.EventName())
+	return nil
+}
+
+func (r *recorder) got() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.names...)
+}
+
+// mutatedNippou returns the events of a created, edited and tagged Nippou.
+func mutatedNippou(t *testing.T) []nippou.Event {
+	t.Helper()
+	n, err := nippou.NewNippou("2024-01-15", "report")
+	if err != nil {
+		t.Fatalf("NewNippou() error = %v", err)
+	}
+	if err := n.UpdateContent("revised"); err != nil {
+		t.Fatalf("UpdateContent() error = %v", err)
+	}
+	if err := n.AddTag("sales"); err != nil {
+		t.Fatalf("AddTag() error = %v", err)
+	}
+	return n.PullEvents()
+}
+
+func equalNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ============================================================================
+// SyncDispatcher Tests
+// ============================================================================
+
+func TestSyncDispatcher_Subscriptions(t *testing.T) {
+	all, tags := &recorder{}, &recorder{}
+	d := NewSyncDispatcher().
+		Subscribe(all.handle).
+		Subscribe(tags.handle, nippou.EventTagAdded, nippou.EventTagRemoved)
+
+	d.Dispatch(context.Background(), mutatedNippou(t))
+
+	want := []string{nippou.EventNippouCreated, nippou.EventContentUpdated, nippou.EventTagAdded}
+	if got := all.got(); !equalNames(got, want) {
+		t.Errorf("all = %v, want %v", got, want)
+	}
+	if got := tags.got(); !equalNames(got, []string{nippou.EventTagAdded}) {
+		t.Errorf("tags = %v, want [TagAdded]", got)
+	}
+}
+
+func TestSyncDispatcher_HandlerFailures(t *testing.T) {
+	var failures []error
+	after := &recorder{}
+	d := NewSyncDispatcher().
+		WithErrorHandler(func(e nippou.Event, err error) { failures = append(failures, err) }).
+		Subscribe(func(ctx context.Context, e nippou.Event) error { return errors.New("mail server down") }).
+		Subscribe(func(ctx context.Context, e nippou.Event) error { panic("boom") }).
+		Subscribe(after.handle)
+
+	events := mutatedNippou(t)
+	d.Dispatch(context.Background(), events)
+
+	if len(failures) != 2*len(events) {
+		t.Errorf("failures = %d, want %d", len(failures), 2*len(events))
+	}
+	if len(after.got()) != len(events) {
+		t.Errorf("later handler saw %d events, want %d", len(after.got()), len(events))
+	}
+}
+
+// ============================================================================
+// AsyncDispatcher Tests
+// ============================================================================
+
+func TestAsyncDispatcher_DeliversInOrderBeforeClose(t *testing.T) {
+	rec := &recorder{}
+	d := NewAsyncDispatcher(1).Subscribe(rec.handle)
+
+	events := mutatedNippou(t)
+	d.Dispatch(context.Background(), events)
+	d.Close()
+	d.Close()
+
+	want := []string{nippou.EventNippouCreated, nippou.EventContentUpdated, nippou.EventTagAdded}
+	if got := rec.got(); !equalNames(got, want) {
+		t.Errorf("delivered = %v, want %v", got, want)
+	}
+}
+
+func TestAsyncDispatcher_DetachesCancellation(t *testing.T) {
+	type key struct{}
+	var (
+		mu     sync.Mutex
+		values []interface{}
+		errs   []error
+	)
+	d := NewAsyncDispatcher(8).Subscribe(func(ctx context.Context, e nippou.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		values = append(values, ctx.Value(key{}))
+		errs = append(errs, ctx.Err())
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "request-1"))
+	d.Dispatch(ctx, mutatedNippou(t)[:1])
+	cancel()
+	d.Close()
+
+	if len(values) != 1 || values[0] != "request-1" || errs[0] != nil {
+		t.Errorf("handler ctx value = %v, err = %v", values, errs)
+	}
+}
+
+func TestAsyncDispatcher_ReportsDroppedEvents(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		dropped []error
+	)
+	d := NewAsyncDispatcher(1).WithErrorHandler(func(e nippou.Event, err error) {
+		mu.Lock()
+		dropped = append(dropped, err)
+		mu.Unlock()
+	})
+	d.Close()
+	d.Dispatch(context.Background(), mutatedNippou(t))
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(dropped) != 3 || !errors.Is(dropped[0], ErrDispatcherClosed) {
+		t.Errorf("dropped = %v, want 3 x ErrDispatcherClosed", dropped)
+	}
+}
+
+func TestAsyncDispatcher_ReentrantDispatchDuringClose(t *testing.T) {
+	events := mutatedNippou(t)
+	var (
+		mu      sync.Mutex
+		dropped []error
+	)
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	var d *AsyncDispatcher
+	d = NewAsyncDispatcher(1).WithErrorHandler(func(e nippou.Event, err error) {
+		mu.Lock()
+		dropped = append(dropped, err)
+		mu.Unlock()
+	}).Subscribe(func(ctx context.Context, e nippou.Event) error {
+		if e.EventName() != nippou.EventNippouCreated {
+			return nil
+		}
+		close(entered)
+		<-release
+		// The queue is full and Close is waiting: this must not block forever
+		d.Dispatch(ctx, events[2:])
+		return nil
+	})
+
+	d.Dispatch(context.Background(), events[:2])
+	<-entered
+	closed := make(chan struct{})
+	go func() {
+		d.Close()
+		close(closed)
+	}()
+	close(release)
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close() deadlocked with a re-entrant Dispatch")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(dropped) != 1 || !errors.Is(dropped[0], ErrDispatcherClosed) {
+		t.Errorf("dropped = %v, want the re-entrant event reported as closed", dropped)
+	}
+}
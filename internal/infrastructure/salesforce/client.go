@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -65,6 +67,19 @@ func (e *APIError) IsForbidden() bool {
 	return e.StatusCode == http.StatusForbidden
 }
 
+// IsRetryable checks if the error is transient (429, 502, 503 or 504).
+func (e *APIError) IsRetryable() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+		http.StatusBadGateway:
+		return true
+	default:
+		return false
+	}
+}
+
 // IsRateLimited checks if the error is a 429 Too Many Requests.
 func (e *APIError) IsRateLimited() bool {
 	return e.StatusCode == http.StatusTooManyRequests
@@ -77,6 +92,37 @@ type sfErrorResponse struct {
 	Fields    []string `json:"fields,omitempty"`
 }
 
+// ============================================================================
+// Response Metadata - Conditional Request Support
+// ============================================================================
+
+// ResponseMeta carries the status and headers of a successful response.
+type ResponseMeta struct {
+	StatusCode int
+	Header     http.Header
+}
+
+// NotModified checks if the response is a 304 Not Modified.
+func (m *ResponseMeta) NotModified() bool {
+	return m != nil && m.StatusCode == http.StatusNotModified
+}
+
+// ETag returns the ETag response header.
+func (m *ResponseMeta) ETag() string {
+	if m == nil {
+		return ""
+	}
+	return m.Header.Get("ETag")
+}
+
+// LastModified returns the Last-Modified response header.
+func (m *ResponseMeta) LastModified() string {
+	if m == nil {
+		return ""
+	}
+	return m.Header.Get("Last-Modified")
+}
+
 // ============================================================================
 // Client Configuration
 // ============================================================================
@@ -110,6 +156,7 @@ type Client struct {
 	config        *ClientConfig
 	httpClient    HTTPDoer
 	tokenProvider TokenProvider
+	logger        *slog.Logger
 }
 
 // NewClient creates a new Salesforce client with the given dependencies.
@@ -121,9 +168,20 @@ func NewClient(config *ClientConfig, httpClient HTTPDoer, tokenProvider TokenPro
 		config:        config,
 		httpClient:    httpClient,
 		tokenProvider: tokenProvider,
+		logger:        slog.New(slog.DiscardHandler),
 	}
 }
 
+// WithLogger sets the logger for request attempts. Each attempt is logged
+// at debug level with method, path, status and attempt number; failures
+// that will be retried are logged as warnings.
+func (c *Client) WithLogger(logger *slog.Logger) *Client {
+	if logger != nil {
+		c.logger = logger
+	}
+	return c
+}
+
 // apiEndpoint constructs the full API endpoint URL.
 func (c *Client) apiEndpoint(path string) string {
 	return fmt.Sprintf("%s/services/data/%s%s", c.config.BaseURL, c.config.APIVersion, path)
@@ -138,6 +196,13 @@ func (c *Client) Get(ctx context.Context, path string, result interface{}) error
 	return c.doRequest(ctx, http.MethodGet, path, nil, result)
 }
 
+// GetConditional performs an HTTP GET request with extra headers such as
+// If-None-Match or If-Modified-Since. A 304 Not Modified response is returned
+// as ResponseMeta with NotModified() true and result left untouched.
+func (c *Client) GetConditional(ctx context.Context, path string, header http.Header, result interface{}) (*ResponseMeta, error) {
+	return c.doRequestWithHeaders(ctx, http.MethodGet, path, header, nil, result)
+}
+
 // Post performs an HTTP POST request with JSON body.
 func (c *Client) Post(ctx context.Context, path string, body, result interface{}) error {
 	return c.doRequest(ctx, http.MethodPost, path, body, result)
@@ -165,6 +230,13 @@ func (c *Client) Query(ctx context.Context, soql string, result interface{}) err
 
 // doRequest executes an HTTP request with authentication and retry logic.
 func (c *Client) doRequest(ctx context.Context, method, path string, body, result interface{}) error {
+	_, err := c.doRequestWithHeaders(ctx, method, path, nil, body, result)
+	return err
+}
+
+// doRequestWithHeaders executes an HTTP request with extra request headers and
+// returns the response metadata of the final attempt.
+func (c *Client) doRequestWithHeaders(ctx context.Context, method, path string, header http.Header, body, result interface{}) (*ResponseMeta, error) {
 	var lastErr error
 
 	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
@@ -172,20 +244,22 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body, resul
 			delay := c.config.RetryBaseDelay * time.Duration(1<<uint(attempt-1))
 			select {
 			case <-ctx.Done():
-				return ctx.Err()
+				return nil, ctx.Err()
 			case <-time.After(delay):
 			}
 		}
 
-		err := c.executeRequest(ctx, method, path, body, result)
+		start := time.Now()
+		meta, err := c.executeRequest(ctx, method, path, header, body, result)
+		c.logAttempt(ctx, method, path, attempt, time.Since(start), meta, err)
 		if err == nil {
-			return nil
+			return meta, nil
 		}
 
 		// Check if error is retryable
 		if apiErr, ok := err.(*APIError); ok {
-			if !c.isRetryable(apiErr) {
-				return err
+			if !apiErr.IsRetryable() {
+				return nil, err
 			}
 			lastErr = err
 			continue
@@ -193,21 +267,45 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body, resul
 
 		// Context errors are not retryable
 		if ctx.Err() != nil {
-			return ctx.Err()
+			return nil, ctx.Err()
 		}
 
 		lastErr = err
 	}
 
-	return lastErr
+	return nil, lastErr
+}
+
+// logAttempt logs one request attempt. The query string is left out because
+// SOQL may contain report content.
+func (c *Client) logAttempt(ctx context.Context, method, path string, attempt int, elapsed time.Duration, meta *ResponseMeta, err error) {
+	path, _, _ = strings.Cut(path, "?")
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("path", path),
+		slog.Int("attempt", attempt+1),
+		slog.Duration("elapsed", elapsed),
+	}
+	switch apiErr, isAPIErr := err.(*APIError); {
+	case err == nil:
+		c.logger.LogAttrs(ctx, slog.LevelDebug, "salesforce request", append(attrs, slog.Int("status", meta.StatusCode))...)
+	case isAPIErr && !apiErr.IsRetryable():
+		c.logger.LogAttrs(ctx, slog.LevelDebug, "salesforce request failed", append(attrs, slog.Int("status", apiErr.StatusCode))...)
+	case isAPIErr && attempt < c.config.MaxRetries:
+		c.logger.LogAttrs(ctx, slog.LevelWarn, "salesforce request failed; retrying", append(attrs, slog.Int("status", apiErr.StatusCode))...)
+	case isAPIErr:
+		c.logger.LogAttrs(ctx, slog.LevelWarn, "salesforce request failed", append(attrs, slog.Int("status", apiErr.StatusCode))...)
+	default:
+		c.logger.LogAttrs(ctx, slog.LevelWarn, "salesforce request failed", append(attrs, slog.Any("error", err))...)
+	}
 }
 
 // executeRequest performs a single HTTP request.
-func (c *Client) executeRequest(ctx context.Context, method, path string, body, result interface{}) error {
+func (c *Client) executeRequest(ctx context.Context, method, path string, header http.Header, body, result interface{}) (*ResponseMeta, error) {
 	// Get auth token
 	token, err := c.tokenProvider.GetToken(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get auth token: %w", err)
+		return nil, fmt.Errorf("failed to get auth token: %w", err)
 	}
 
 	// Build request
@@ -216,17 +314,22 @@ func (c *Client) executeRequest(ctx context.Context, method, path string, body,
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		bodyReader = bytes.NewReader(jsonBody)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
+	for key, values := range header {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
 	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
@@ -234,29 +337,36 @@ func (c *Client) executeRequest(ctx context.Context, method, path string, body,
 	// Execute request
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Read response body
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	// Check for errors
 	if resp.StatusCode >= 400 {
-		return c.parseAPIError(resp.StatusCode, respBody)
+		return nil, c.parseAPIError(resp.StatusCode, respBody)
+	}
+
+	meta := &ResponseMeta{StatusCode: resp.StatusCode, Header: resp.Header}
+
+	// 304 Not Modified carries no body; the caller keeps its cached copy
+	if resp.StatusCode == http.StatusNotModified {
+		return meta, nil
 	}
 
 	// Parse successful response
 	if result != nil && len(respBody) > 0 {
 		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("failed to parse response: %w", err)
 		}
 	}
 
-	return nil
+	return meta, nil
 }
 
 // parseAPIError converts HTTP error response to APIError.
@@ -286,19 +396,6 @@ func (c *Client) parseAPIError(statusCode int, body []byte) *APIError {
 	return apiErr
 }
 
-// isRetryable determines if an API error should trigger a retry.
-func (c *Client) isRetryable(err *APIError) bool {
-	switch err.StatusCode {
-	case http.StatusTooManyRequests,
-		http.StatusServiceUnavailable,
-		http.StatusGatewayTimeout,
-		http.StatusBadGateway:
-		return true
-	default:
-		return false
-	}
-}
-
 // ============================================================================
 // SObject Operations
 // ============================================================================
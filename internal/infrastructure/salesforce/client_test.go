@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -204,6 +206,51 @@ func TestClient_Retry_RateLimited(t *testing.T) {
 	}
 }
 
+func TestClient_LogsAttempts(t *testing.T) {
+	callCount := 0
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			callCount++
+			if callCount == 1 {
+				return newMockResponse(503, []sfErrorResponse{{Message: "Unavailable", ErrorCode: "SERVER_UNAVAILABLE"}}), nil
+			}
+			return newMockResponse(200, map[string]string{"ok": "true"}), nil
+		},
+	}
+	config := DefaultConfig("https://test.salesforce.com")
+	config.RetryBaseDelay = time.Millisecond
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewClient(config, mockHTTP, &MockTokenProvider{Token: "test-token"}).WithLogger(logger)
+
+	if err := client.Query(context.Background(), "SELECT Id FROM Nippou__c WHERE Content__c = 'secret'", &map[string]interface{}{}); err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+
+	var entries []map[string]interface{}
+	for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+		var entry map[string]interface{}
+		if err := json.Unmarshal(line, &entry); err != nil {
+			t.Fatalf("log line %s: %v", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("logged %d entries, want 2: %s", len(entries), buf.String())
+	}
+	retry, ok := entries[0], entries[1]
+	if retry["level"] != "WARN" || retry["status"] != float64(503) || retry["attempt"] != float64(1) {
+		t.Errorf("retry entry = %v", retry)
+	}
+	if ok["level"] != "DEBUG" || ok["status"] != float64(200) || ok["attempt"] != float64(2) ||
+		ok["method"] != "GET" || ok["path"] != "/query" {
+		t.Errorf("success entry = %v", ok)
+	}
+	if strings.Contains(buf.String(), "secret") {
+		t.Error("query string should not be logged")
+	}
+}
+
 // ============================================================================
 // Repository Tests
 // ============================================================================
@@ -272,7 +319,7 @@ func TestNippouRepository_FindByDate(t *testing.T) {
 	client := newTestClient(mockHTTP)
 	repo := NewNippouRepository(client)
 
-	date := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	date, _ := nippou.ParseCivilDate("2024-01-15")
 	results, err := repo.FindByDate(date)
 
 	if err != nil {
@@ -402,9 +449,11 @@ func TestEscapeSOQL(t *testing.T) {
 		expected string
 	}{
 		{"simple", "simple"},
-		{"it's a test", "it''s a test"},
+		{"it's a test", "it\\'s a test"},
 		{"back\\slash", "back\\\\slash"},
-		{"mixed's\\test", "mixed''s\\\\test"},
+		{"mixed's\\test", "mixed\\'s\\\\test"},
+		{"quote\\'", "quote\\\\\\'"},
+		{"line\nbreak", "line\\nbreak"},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,274 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// NippouComment__c - Salesforce Custom Object Mapping
+// ============================================================================
+
+// NippouCommentSF represents the Salesforce NippouComment__c child object.
+// Nippou__c is a master-detail lookup and only accepts the parent's Salesforce
+// record Id, so the domain IDs are kept in their own fields: Comment_Id__c is
+// an external ID and Nippou_Id__c holds the parent's domain ID for queries.
+type NippouCommentSF struct {
+	ID          string `json:"Id,omitempty"`
+	CommentID   string `json:"Comment_Id__c,omitempty"` // Text(36), external ID, unique
+	NippouID    string `json:"Nippou_Id__c,omitempty"`  // Text(36)
+	Nippou      string `json:"Nippou__c,omitempty"`     // Master-detail (Nippou__c)
+	Author      string `json:"Author__c,omitempty"`     // Text(100)
+	Body        string `json:"Body__c,omitempty"`       // Long text area (4000)
+	CreatedDate string `json:"CreatedDate,omitempty"`
+}
+
+// CommentFromDomain converts a domain Comment to NippouCommentSF. The
+// master-detail Nippou__c is left empty; the repository resolves it.
+func CommentFromDomain(c *nippou.Comment) *NippouCommentSF {
+	if c == nil {
+		return nil
+	}
+	return &NippouCommentSF{
+		CommentID: c.ID().String(),
+		NippouID:  c.NippouID().String(),
+		Author:    c.Author(),
+		Body:      c.Body(),
+	}
+}
+
+// ToCreatePayload returns the fields sent when creating the record.
+func (sf *NippouCommentSF) ToCreatePayload() map[string]interface{} {
+	return map[string]interface{}{
+		"Comment_Id__c": sf.CommentID,
+		"Nippou_Id__c":  sf.NippouID,
+		"Nippou__c":     sf.Nippou,
+		"Author__c":     sf.Author,
+		"Body__c":       sf.Body,
+	}
+}
+
+// ToDomain converts a NippouCommentSF to a domain Comment.
+func (sf *NippouCommentSF) ToDomain() (*nippou.Comment, error) {
+	if sf == nil {
+		return nil, nil
+	}
+	return nippou.ReconstructComment(nippou.ReconstructedComment{
+		ID:        sf.CommentID,
+		NippouID:  sf.NippouID,
+		Author:    sf.Author,
+		Body:      sf.Body,
+		CreatedAt: parseTimestamp(sf.CreatedDate),
+	})
+}
+
+// CommentQueryResult represents a SOQL query response for NippouComment__c.
+type CommentQueryResult struct {
+	TotalSize int               `json:"totalSize"`
+	Done      bool              `json:"done"`
+	Records   []NippouCommentSF `json:"records"`
+}
+
+// ============================================================================
+// CommentRepository - Implements domain.CommentRepository
+// ============================================================================
+
+// CommentRepository persists comments as NippouComment__c records.
+type CommentRepository struct {
+	client *Client
+	ctx    context.Context
+}
+
+// NewCommentRepository creates a new CommentRepository with the given Salesforce client.
+func NewCommentRepository(client *Client) *CommentRepository {
+	return &CommentRepository{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// WithContext returns a new repository with the given context.
+func (r *CommentRepository) WithContext(ctx context.Context) *CommentRepository {
+	return &CommentRepository{
+		client: r.client,
+		ctx:    ctx,
+	}
+}
+
+// FindCommentByID retrieves a comment by its ID.
+// Returns nil if the record is not found.
+func (r *CommentRepository) FindCommentByID(id nippou.ID) (*nippou.Comment, error) {
+	if id.IsEmpty() {
+		return nil, &RepositoryError{
+			Operation: "FindCommentByID",
+			Cause:     fmt.Errorf("empty ID provided"),
+		}
+	}
+
+	records, err := r.queryRecords(r.selectComment().Where(Eq("Comment_Id__c", StringLiteral(id.String()))).Limit(1), "FindCommentByID")
+	if err != nil || len(records) == 0 {
+		return nil, err
+	}
+	c, err := records[0].ToDomain()
+	if err != nil {
+		return nil, &RepositoryError{
+			Operation: "FindCommentByID",
+			Cause:     fmt.Errorf("failed to convert to domain: %w", err),
+		}
+	}
+	return c, nil
+}
+
+// FindByNippouID retrieves the comments on a Nippou, oldest first.
+func (r *CommentRepository) FindByNippouID(nippouID nippou.ID) ([]*nippou.Comment, error) {
+	if nippouID.IsEmpty() {
+		return nil, &RepositoryError{
+			Operation: "FindByNippouID",
+			Cause:     fmt.Errorf("empty ID provided"),
+		}
+	}
+
+	query := r.selectComment().
+		Where(Eq("Nippou_Id__c", StringLiteral(nippouID.String()))).
+		OrderByAsc("CreatedDate")
+
+	return r.executeQuery(query, "FindByNippouID")
+}
+
+// SaveComment creates a NippouComment__c record. Comments are immutable, so
+// there is no update path.
+func (r *CommentRepository) SaveComment(c *nippou.Comment) error {
+	if c == nil {
+		return &RepositoryError{
+			Operation: "SaveComment",
+			Cause:     fmt.Errorf("nil Comment provided"),
+		}
+	}
+
+	sf := CommentFromDomain(c)
+	parent, err := r.nippouRecordID(c.NippouID())
+	if err != nil {
+		return &RepositoryError{
+			Operation: "SaveComment",
+			Cause:     err,
+		}
+	}
+	sf.Nippou = parent
+
+	result, err := r.client.CreateSObject(r.ctx, NippouCommentObjectName, sf.ToCreatePayload())
+	if err != nil {
+		return &RepositoryError{
+			Operation: "SaveComment",
+			Cause:     err,
+		}
+	}
+	if !result.Success {
+		return &RepositoryError{
+			Operation: "SaveComment",
+			Cause:     fmt.Errorf("Salesforce create returned success=false"),
+		}
+	}
+	return nil
+}
+
+// DeleteComment removes a comment by its ID.
+func (r *CommentRepository) DeleteComment(id nippou.ID) error {
+	if id.IsEmpty() {
+		return &RepositoryError{
+			Operation: "DeleteComment",
+			Cause:     fmt.Errorf("empty ID provided"),
+		}
+	}
+
+	// Delete through the external ID; the Salesforce record Id is not known
+	path := fmt.Sprintf("/sobjects/%s/Comment_Id__c/%s", NippouCommentObjectName, url.PathEscape(id.String()))
+	if err := r.client.Delete(r.ctx, path); err != nil {
+		// Not found is not an error for delete operations
+		if apiErr, ok := err.(*APIError); ok && apiErr.IsNotFound() {
+			return nil
+		}
+		return &RepositoryError{
+			Operation: "DeleteComment",
+			Cause:     err,
+		}
+	}
+	return nil
+}
+
+// commentFields is the SELECT list for NippouComment__c, derived from NippouCommentSF.
+var commentFields = SOQLFields(NippouCommentSF{})
+
+// selectComment starts a query selecting every NippouCommentSF field.
+func (r *CommentRepository) selectComment() *QueryBuilder {
+	return NewQuery(NippouCommentObjectName).Select(commentFields...)
+}
+
+// nippouRecordID returns the Salesforce record Id of the Nippou__c holding
+// the domain ID id, for the master-detail Nippou__c field.
+func (r *CommentRepository) nippouRecordID(id nippou.ID) (string, error) {
+	soql, err := NewQuery(NippouObjectName).Select("Id").Where(nippouIDCondition(id)).Limit(1).Build()
+	if err != nil {
+		return "", err
+	}
+	var result QueryResult
+	if err := r.client.Query(r.ctx, url.QueryEscape(soql), &result); err != nil {
+		return "", fmt.Errorf("failed to resolve nippou %s: %w", id, err)
+	}
+	if len(result.Records) == 0 || result.Records[0].ID == "" {
+		return "", fmt.Errorf("nippou %s not found", id)
+	}
+	return result.Records[0].ID, nil
+}
+
+// executeQuery builds and runs a SOQL query and converts results to domain
+// comments. Records that fail to convert are logged and skipped so one bad
+// record does not hide the rest of the thread.
+func (r *CommentRepository) executeQuery(query *QueryBuilder, operation string) ([]*nippou.Comment, error) {
+	records, err := r.queryRecords(query, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*nippou.Comment, 0, len(records))
+	for _, record := range records {
+		c, err := record.ToDomain()
+		if err != nil {
+			r.client.logger.LogAttrs(r.ctx, slog.LevelWarn, "skipping unreadable comment",
+				slog.String("operation", operation), slog.String("id", record.ID), slog.Any("error", err))
+			continue
+		}
+		comments = append(comments, c)
+	}
+	return comments, nil
+}
+
+// queryRecords builds and runs a SOQL query for NippouComment__c records.
+func (r *CommentRepository) queryRecords(query *QueryBuilder, operation string) ([]NippouCommentSF, error) {
+	soql, err := query.Build()
+	if err != nil {
+		return nil, &RepositoryError{
+			Operation: operation,
+			Cause:     err,
+		}
+	}
+
+	var result CommentQueryResult
+	if err := r.client.Query(r.ctx, url.QueryEscape(soql), &result); err != nil {
+		return nil, &RepositoryError{
+			Operation: operation,
+			Cause:     err,
+		}
+	}
+	return result.Records, nil
+}
+
+// ============================================================================
+// Compile-time Interface Compliance Check
+// ============================================================================
+
+// Ensure CommentRepository implements nippou.CommentRepository at compile time.
+var _ nippou.CommentRepository = (*CommentRepository)(nil)
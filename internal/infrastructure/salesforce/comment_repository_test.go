@@ -0,0 +1,172 @@
+package salesforce
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// CommentRepository Tests
+// ============================================================================
+
+const testNippouID = "550e8400-e29b-41d4-a716-446655440000"
+
+// testNippouRecordID is the Salesforce record Id of the parent Nippou__c.
+const testNippouRecordID = "a0B5g00000ABCDEFGH"
+
+func TestCommentRepository_SaveComment(t *testing.T) {
+	var gotPath, gotSOQL string
+	var payload map[string]interface{}
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodGet {
+				gotSOQL, _ = url.QueryUnescape(strings.TrimPrefix(req.URL.RawQuery, "q="))
+				return newMockResponse(200, QueryResult{TotalSize: 1, Done: true, Records: []NippouSF{{ID: testNippouRecordID}}}), nil
+			}
+			gotPath = req.URL.Path
+			body, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &payload)
+			return newMockResponse(201, CreateSObjectResult{ID: "a0X000000000001", Success: true}), nil
+		},
+	}
+	repo := NewCommentRepository(newTestClient(mockHTTP))
+
+	nippouID, _ := nippou.IDFromString(testNippouID)
+	c, _ := nippou.NewComment(nippouID, "佐藤", "見積を添付してください")
+	if err := repo.SaveComment(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "SELECT Id FROM Nippou__c WHERE Id = '" + testNippouID + "' LIMIT 1"; gotSOQL != want {
+		t.Errorf("parent SOQL = %s, want %s", gotSOQL, want)
+	}
+	if !strings.HasSuffix(gotPath, "/sobjects/NippouComment__c") {
+		t.Errorf("unexpected path: %s", gotPath)
+	}
+	if payload["Nippou__c"] != testNippouRecordID || payload["Nippou_Id__c"] != testNippouID || payload["Comment_Id__c"] != c.ID().String() ||
+		payload["Author__c"] != "佐藤" || payload["Body__c"] != "見積を添付してください" {
+		t.Errorf("unexpected payload: %v", payload)
+	}
+}
+
+func TestCommentRepository_SaveComment_MissingParent(t *testing.T) {
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet {
+				t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+			}
+			return newMockResponse(200, QueryResult{Done: true}), nil
+		},
+	}
+	repo := NewCommentRepository(newTestClient(mockHTTP))
+
+	c, _ := nippou.NewComment(nippou.NewID(), "佐藤", "body")
+	var repoErr *RepositoryError
+	if err := repo.SaveComment(c); !errors.As(err, &repoErr) {
+		t.Errorf("expected RepositoryError for a missing parent, got %v", err)
+	}
+}
+
+func TestCommentRepository_FindByNippouID(t *testing.T) {
+	var gotSOQL string
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			gotSOQL, _ = url.QueryUnescape(strings.TrimPrefix(req.URL.RawQuery, "q="))
+			return newMockResponse(200, CommentQueryResult{TotalSize: 2, Done: true, Records: []NippouCommentSF{
+				{ID: "a0X000000000001", CommentID: "550e8400-e29b-41d4-a716-446655440010", NippouID: testNippouID, Nippou: testNippouRecordID,
+					Author: "佐藤", Body: "Good", CreatedDate: "2024-01-15T09:00:00.000+0900"},
+				{ID: "a0X000000000002", CommentID: "invalid", NippouID: testNippouID, Nippou: testNippouRecordID, Author: "x", Body: "skipped"},
+			}}), nil
+		},
+	}
+	var logs bytes.Buffer
+	client := newTestClient(mockHTTP).WithLogger(slog.New(slog.NewTextHandler(&logs, nil)))
+	repo := NewCommentRepository(client)
+
+	nippouID, _ := nippou.IDFromString(testNippouID)
+	comments, err := repo.FindByNippouID(nippouID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "SELECT " + strings.Join(commentFields, ", ") + " FROM NippouComment__c WHERE Nippou_Id__c = '" +
+		testNippouID + "' ORDER BY CreatedDate ASC"
+	if gotSOQL != expected {
+		t.Errorf("SOQL =\n  %s\nwant\n  %s", gotSOQL, expected)
+	}
+	if len(comments) != 1 || comments[0].Author() != "佐藤" || comments[0].CreatedAt().UTC().Hour() != 0 ||
+		comments[0].ID().String() != "550e8400-e29b-41d4-a716-446655440010" || comments[0].NippouID().String() != testNippouID {
+		t.Errorf("unexpected comments: %+v", comments)
+	}
+	if !strings.Contains(logs.String(), "skipping unreadable comment") || !strings.Contains(logs.String(), "a0X000000000002") {
+		t.Errorf("skipped record was not logged: %s", logs.String())
+	}
+}
+
+func TestCommentRepository_FindCommentByID_Unreadable(t *testing.T) {
+	var gotSOQL string
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			gotSOQL, _ = url.QueryUnescape(strings.TrimPrefix(req.URL.RawQuery, "q="))
+			return newMockResponse(200, CommentQueryResult{TotalSize: 1, Done: true, Records: []NippouCommentSF{
+				{ID: "a0X000000000001", CommentID: testNippouID, NippouID: "invalid", Author: "x", Body: "b"},
+			}}), nil
+		},
+	}
+	repo := NewCommentRepository(newTestClient(mockHTTP))
+
+	id, _ := nippou.IDFromString(testNippouID)
+	var repoErr *RepositoryError
+	if _, err := repo.FindCommentByID(id); !errors.As(err, &repoErr) {
+		t.Errorf("expected RepositoryError for an unreadable record, got %v", err)
+	}
+	if !strings.Contains(gotSOQL, "WHERE Comment_Id__c = '"+testNippouID+"'") {
+		t.Errorf("unexpected SOQL: %s", gotSOQL)
+	}
+}
+
+func TestCommentRepository_FindCommentByID_NotFound(t *testing.T) {
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return newMockResponse(200, CommentQueryResult{Done: true}), nil
+		},
+	}
+	repo := NewCommentRepository(newTestClient(mockHTTP))
+
+	id, _ := nippou.IDFromString(testNippouID)
+	c, err := repo.FindCommentByID(id)
+	if err != nil || c != nil {
+		t.Errorf("FindCommentByID() = %v, %v; want nil, nil", c, err)
+	}
+}
+
+func TestCommentRepository_DeleteComment(t *testing.T) {
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodDelete || !strings.HasSuffix(req.URL.Path, "/sobjects/NippouComment__c/Comment_Id__c/"+testNippouID) {
+				t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+			}
+			return newMockResponse(404, []sfErrorResponse{{Message: "Record not found", ErrorCode: "NOT_FOUND"}}), nil
+		},
+	}
+	repo := NewCommentRepository(newTestClient(mockHTTP))
+
+	id, _ := nippou.IDFromString(testNippouID)
+	if err := repo.DeleteComment(id); err != nil {
+		t.Errorf("delete of non-existent comment should succeed, got: %v", err)
+	}
+
+	var repoErr *RepositoryError
+	if err := repo.DeleteComment(nippou.ID{}); !errors.As(err, &repoErr) {
+		t.Errorf("expected RepositoryError for empty ID, got %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+// ============================================================================
+// Describe Results - sObject Metadata
+// ============================================================================
+
+// DescribeGlobalResult represents the response of GET /sobjects.
+type DescribeGlobalResult struct {
+	Encoding     string                  `json:"encoding"`
+	MaxBatchSize int                     `json:"maxBatchSize"`
+	SObjects     []DescribeGlobalSObject `json:"sobjects"`
+}
+
+// DescribeGlobalSObject is the summary of one sObject in a global describe.
+type DescribeGlobalSObject struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	LabelPlural string `json:"labelPlural"`
+	KeyPrefix   string `json:"keyPrefix,omitempty"`
+	Custom      bool   `json:"custom"`
+	Createable  bool   `json:"createable"`
+	Deletable   bool   `json:"deletable"`
+	Queryable   bool   `json:"queryable"`
+	Searchable  bool   `json:"searchable"`
+	Updateable  bool   `json:"updateable"`
+}
+
+// SObjectDescribe represents the response of GET /sobjects/{name}/describe.
+type SObjectDescribe struct {
+	Name               string              `json:"name"`
+	Label              string              `json:"label"`
+	LabelPlural        string              `json:"labelPlural"`
+	KeyPrefix          string              `json:"keyPrefix,omitempty"`
+	Custom             bool                `json:"custom"`
+	Createable         bool                `json:"createable"`
+	Deletable          bool                `json:"deletable"`
+	Queryable          bool                `json:"queryable"`
+	Searchable         bool                `json:"searchable"`
+	Updateable         bool                `json:"updateable"`
+	Fields             []FieldDescribe     `json:"fields"`
+	ChildRelationships []ChildRelationship `json:"childRelationships,omitempty"`
+}
+
+// Field returns the field with the given API name, if present.
+func (d *SObjectDescribe) Field(name string) (*FieldDescribe, bool) {
+	if d == nil {
+		return nil, false
+	}
+	for i := range d.Fields {
+		if d.Fields[i].Name == name {
+			return &d.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// FieldDescribe is the metadata of a single sObject field.
+type FieldDescribe struct {
+	Name             string          `json:"name"`
+	Label            string          `json:"label"`
+	Type             string          `json:"type"` // e.g. "string", "textarea", "double", "date", "boolean"
+	Length           int             `json:"length"`
+	Precision        int             `json:"precision"`
+	Scale            int             `json:"scale"`
+	Custom           bool            `json:"custom"`
+	Nillable         bool            `json:"nillable"`
+	Createable       bool            `json:"createable"`
+	Updateable       bool            `json:"updateable"`
+	Filterable       bool            `json:"filterable"`
+	Sortable         bool            `json:"sortable"`
+	Unique           bool            `json:"unique"`
+	ExternalID       bool            `json:"externalId"`
+	Calculated       bool            `json:"calculated"`
+	ReferenceTo      []string        `json:"referenceTo,omitempty"`
+	RelationshipName string          `json:"relationshipName,omitempty"`
+	PicklistValues   []PicklistEntry `json:"picklistValues,omitempty"`
+	Restricted       bool            `json:"restrictedPicklist"`
+}
+
+// PicklistEntry is one value of a picklist or multi-select picklist field.
+type PicklistEntry struct {
+	Value        string `json:"value"`
+	Label        string `json:"label"`
+	Active       bool   `json:"active"`
+	DefaultValue bool   `json:"defaultValue"`
+}
+
+// ChildRelationship describes a relationship from a child sObject.
+type ChildRelationship struct {
+	ChildSObject     string `json:"childSObject"`
+	Field            string `json:"field"`
+	RelationshipName string `json:"relationshipName,omitempty"`
+	CascadeDelete    bool   `json:"cascadeDelete"`
+}
+
+// ============================================================================
+// Describe Operations
+// ============================================================================
+
+// sobjectNamePattern matches valid sObject API names (e.g. Account, Nippou__c,
+// ns__Obj__c, Nippou__ChangeEvent).
+var sobjectNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// ValidateSObjectName checks that name is a well-formed sObject API name,
+// so it can be safely embedded in a REST path.
+func ValidateSObjectName(name string) error {
+	if !sobjectNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid sObject name %q", name)
+	}
+	return nil
+}
+
+// DescribeGlobal lists all sObjects available to the current user.
+func (c *Client) DescribeGlobal(ctx context.Context) (*DescribeGlobalResult, error) {
+	result, _, err := c.describeGlobalConditional(ctx, nil)
+	return result, err
+}
+
+// DescribeSObject retrieves the full metadata for one sObject.
+func (c *Client) DescribeSObject(ctx context.Context, objectName string) (*SObjectDescribe, error) {
+	result, _, err := c.describeSObjectConditional(ctx, objectName, nil)
+	return result, err
+}
+
+// describeGlobalConditional performs a global describe with conditional headers.
+// On 304 Not Modified the returned result is nil.
+func (c *Client) describeGlobalConditional(ctx context.Context, header http.Header) (*DescribeGlobalResult, *ResponseMeta, error) {
+	var result DescribeGlobalResult
+	meta, err := c.GetConditional(ctx, "/sobjects", header, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+	if meta.NotModified() {
+		return nil, meta, nil
+	}
+	return &result, meta, nil
+}
+
+// describeSObjectConditional performs an sObject describe with conditional headers.
+// On 304 Not Modified the returned result is nil.
+func (c *Client) describeSObjectConditional(ctx context.Context, objectName string, header http.Header) (*SObjectDescribe, *ResponseMeta, error) {
+	if err := ValidateSObjectName(objectName); err != nil {
+		return nil, nil, err
+	}
+	var result SObjectDescribe
+	path := fmt.Sprintf("/sobjects/%s/describe", objectName)
+	meta, err := c.GetConditional(ctx, path, header, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+	if meta.NotModified() {
+		return nil, meta, nil
+	}
+	return &result, meta, nil
+}
@@ -0,0 +1,203 @@
+package salesforce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// DescribeCache - ETag-Aware Metadata Cache
+// ============================================================================
+
+// DescribeCacheConfig holds configuration for DescribeCache.
+type DescribeCacheConfig struct {
+	TTL time.Duration    // How long an entry is served without revalidation
+	Now func() time.Time // Clock (useful for testing)
+}
+
+// DefaultDescribeCacheConfig returns sensible default configuration.
+// Describe metadata changes rarely, so entries stay fresh for 15 minutes.
+func DefaultDescribeCacheConfig() *DescribeCacheConfig {
+	return &DescribeCacheConfig{
+		TTL: 15 * time.Minute,
+		Now: time.Now,
+	}
+}
+
+// describeEntry is a cached describe result with its validators.
+type describeEntry[T any] struct {
+	value        *T
+	etag         string
+	lastModified string
+	expiresAt    time.Time
+}
+
+// DescribeCache caches DescribeGlobal and DescribeSObject results.
+// Fresh entries are served directly. Expired entries are revalidated with
+// If-None-Match / If-Modified-Since; a 304 Not Modified extends the entry's
+// lifetime without re-downloading it. If revalidation fails with a transient
+// error, the stale entry is served instead.
+type DescribeCache struct {
+	client *Client
+	config *DescribeCacheConfig
+
+	mu       sync.Mutex
+	global   *describeEntry[DescribeGlobalResult]
+	sobjects map[string]*describeEntry[SObjectDescribe]
+}
+
+// NewDescribeCache creates a DescribeCache backed by client.
+// A nil config uses DefaultDescribeCacheConfig.
+func NewDescribeCache(client *Client, config *DescribeCacheConfig) *DescribeCache {
+	if config == nil {
+		config = DefaultDescribeCacheConfig()
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &DescribeCache{
+		client:   client,
+		config:   config,
+		sobjects: make(map[string]*describeEntry[SObjectDescribe]),
+	}
+}
+
+// DescribeGlobal returns the cached global describe, revalidating when stale.
+func (c *DescribeCache) DescribeGlobal(ctx context.Context) (*DescribeGlobalResult, error) {
+	c.mu.Lock()
+	entry := c.global
+	c.mu.Unlock()
+
+	updated, err := refreshDescribe(c, entry, func(header http.Header) (*DescribeGlobalResult, *ResponseMeta, error) {
+		return c.client.describeGlobalConditional(ctx, header)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.global = updated
+	c.mu.Unlock()
+	return updated.value, nil
+}
+
+// DescribeSObject returns the cached describe of objectName, revalidating when stale.
+func (c *DescribeCache) DescribeSObject(ctx context.Context, objectName string) (*SObjectDescribe, error) {
+	c.mu.Lock()
+	entry := c.sobjects[objectName]
+	c.mu.Unlock()
+
+	updated, err := refreshDescribe(c, entry, func(header http.Header) (*SObjectDescribe, *ResponseMeta, error) {
+		return c.client.describeSObjectConditional(ctx, objectName, header)
+	})
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			c.Invalidate(objectName)
+		}
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.sobjects[objectName] = updated
+	c.mu.Unlock()
+	return updated.value, nil
+}
+
+// Invalidate drops the cached describe of objectName.
+func (c *DescribeCache) Invalidate(objectName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.sobjects, objectName)
+}
+
+// InvalidateAll drops every cached describe.
+func (c *DescribeCache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.global = nil
+	c.sobjects = make(map[string]*describeEntry[SObjectDescribe])
+}
+
+// ============================================================================
+// Internal Revalidation
+// ============================================================================
+
+// refreshDescribe returns entry if fresh; otherwise it fetches (conditionally
+// when validators are known) and returns the new or revalidated entry.
+func refreshDescribe[T any](
+	c *DescribeCache,
+	entry *describeEntry[T],
+	fetch func(header http.Header) (*T, *ResponseMeta, error),
+) (*describeEntry[T], error) {
+	now := c.config.Now()
+	if entry != nil && now.Before(entry.expiresAt) {
+		return entry, nil
+	}
+
+	header := make(http.Header)
+	if entry != nil {
+		if entry.etag != "" {
+			header.Set("If-None-Match", entry.etag)
+		}
+		if entry.lastModified != "" {
+			header.Set("If-Modified-Since", entry.lastModified)
+		}
+	}
+
+	value, meta, err := fetch(header)
+	if err != nil {
+		if entry != nil && c.isTransient(err) {
+			return entry, nil
+		}
+		return nil, err
+	}
+
+	if meta.NotModified() && entry != nil {
+		return &describeEntry[T]{
+			value:        entry.value,
+			etag:         firstNonEmpty(meta.ETag(), entry.etag),
+			lastModified: firstNonEmpty(meta.LastModified(), entry.lastModified),
+			expiresAt:    now.Add(c.config.TTL),
+		}, nil
+	}
+	if value == nil {
+		// 304 without a cached entry: only possible with a misbehaving server
+		return nil, &APIError{StatusCode: http.StatusNotModified, Message: "not modified response without cached describe"}
+	}
+
+	return &describeEntry[T]{
+		value:        value,
+		etag:         meta.ETag(),
+		lastModified: meta.LastModified(),
+		expiresAt:    now.Add(c.config.TTL),
+	}, nil
+}
+
+// isTransient reports whether a failed revalidation may fall back to a stale
+// entry. A cancelled or expired context is the caller giving up, not an
+// outage, so it is reported rather than hidden behind stale data.
+func (c *DescribeCache) isTransient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.IsRetryable()
+	}
+	// Network failures
+	return true
+}
+
+// firstNonEmpty returns the first non-empty string.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
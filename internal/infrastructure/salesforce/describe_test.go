@@ -0,0 +1,271 @@
+package salesforce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Describe Tests
+// ============================================================================
+
+// nippouDescribe is a minimal describe payload for Nippou__c.
+var nippouDescribe = SObjectDescribe{
+	Name:   "Nippou__c",
+	Label:  "Nippou",
+	Custom: true,
+	Fields: []FieldDescribe{
+		{Name: "Id", Type: "id", Length: 18},
+		{Name: "Address__c", Type: "string", Length: 500, Custom: true},
+		{Name: "Latitude__c", Type: "double", Precision: 10, Scale: 7, Custom: true},
+	},
+}
+
+func TestClient_DescribeGlobal(t *testing.T) {
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path != "/services/data/v59.0/sobjects" {
+				t.Errorf("unexpected path: %s", req.URL.Path)
+			}
+			return newMockResponse(200, DescribeGlobalResult{
+				Encoding: "UTF-8",
+				SObjects: []DescribeGlobalSObject{
+					{Name: "Account", Queryable: true},
+					{Name: "Nippou__c", Custom: true, Queryable: true},
+				},
+			}), nil
+		},
+	}
+
+	client := newTestClient(mockHTTP)
+	result, err := client.DescribeGlobal(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.SObjects) != 2 || !result.SObjects[1].Custom {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestClient_DescribeSObject(t *testing.T) {
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path != "/services/data/v59.0/sobjects/Nippou__c/describe" {
+				t.Errorf("unexpected path: %s", req.URL.Path)
+			}
+			return newMockResponse(200, nippouDescribe), nil
+		},
+	}
+
+	client := newTestClient(mockHTTP)
+	result, err := client.DescribeSObject(context.Background(), "Nippou__c")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	field, ok := result.Field("Address__c")
+	if !ok {
+		t.Fatal("expected Address__c field")
+	}
+	if field.Length != 500 {
+		t.Errorf("expected length 500, got %d", field.Length)
+	}
+	if _, ok := result.Field("Missing__c"); ok {
+		t.Error("expected Missing__c to be absent")
+	}
+}
+
+func TestClient_DescribeSObject_InvalidName(t *testing.T) {
+	client := newTestClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			t.Fatal("no request expected for invalid name")
+			return nil, nil
+		},
+	})
+
+	for _, name := range []string{"", "../limits", "Nippou__c/describe", "1Obj"} {
+		if _, err := client.DescribeSObject(context.Background(), name); err == nil {
+			t.Errorf("expected error for %q", name)
+		}
+	}
+}
+
+// ============================================================================
+// DescribeCache Tests
+// ============================================================================
+
+// describeServer serves nippouDescribe with an ETag and honors If-None-Match.
+type describeServer struct {
+	etag        string
+	calls       int
+	conditional int
+	failWith    int
+	failErr     error // Transport error returned instead of a response
+}
+
+func (s *describeServer) Do(req *http.Request) (*http.Response, error) {
+	s.calls++
+	if s.failErr != nil {
+		return nil, s.failErr
+	}
+	if s.failWith != 0 {
+		return newMockResponse(s.failWith, []sfErrorResponse{{Message: "unavailable"}}), nil
+	}
+	if inm := req.Header.Get("If-None-Match"); inm != "" {
+		s.conditional++
+		if inm == s.etag {
+			resp := newMockResponse(http.StatusNotModified, nil)
+			resp.Header.Set("ETag", s.etag)
+			return resp, nil
+		}
+	}
+	resp := newMockResponse(200, nippouDescribe)
+	resp.Header.Set("ETag", s.etag)
+	resp.Header.Set("Last-Modified", "Mon, 15 Jan 2024 10:00:00 GMT")
+	return resp, nil
+}
+
+func newDescribeCacheForTest(server *describeServer, now *time.Time) *DescribeCache {
+	config := DefaultConfig("https://test.salesforce.com")
+	config.MaxRetries = 0
+	client := NewClient(config, server, &MockTokenProvider{Token: "test-token"})
+	return NewDescribeCache(client, &DescribeCacheConfig{
+		TTL: time.Minute,
+		Now: func() time.Time { return *now },
+	})
+}
+
+func TestDescribeCache_ServesFreshEntry(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	server := &describeServer{etag: `"v1"`}
+	cache := newDescribeCacheForTest(server, &now)
+
+	for i := 0; i < 3; i++ {
+		if _, err := cache.DescribeSObject(context.Background(), "Nippou__c"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if server.calls != 1 {
+		t.Errorf("expected 1 call, got %d", server.calls)
+	}
+}
+
+func TestDescribeCache_RevalidatesWith304(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	server := &describeServer{etag: `"v1"`}
+	cache := newDescribeCacheForTest(server, &now)
+
+	first, _ := cache.DescribeSObject(context.Background(), "Nippou__c")
+	now = now.Add(2 * time.Minute)
+
+	second, err := cache.DescribeSObject(context.Background(), "Nippou__c")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if server.conditional != 1 {
+		t.Errorf("expected conditional request, got %d", server.conditional)
+	}
+	if second != first {
+		t.Error("expected cached describe to be reused after 304")
+	}
+
+	// The 304 extends the lifetime, so no further request is made
+	_, _ = cache.DescribeSObject(context.Background(), "Nippou__c")
+	if server.calls != 2 {
+		t.Errorf("expected 2 calls, got %d", server.calls)
+	}
+}
+
+func TestDescribeCache_ChangedETagRefetches(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	server := &describeServer{etag: `"v1"`}
+	cache := newDescribeCacheForTest(server, &now)
+
+	first, _ := cache.DescribeSObject(context.Background(), "Nippou__c")
+	server.etag = `"v2"`
+	now = now.Add(2 * time.Minute)
+
+	second, err := cache.DescribeSObject(context.Background(), "Nippou__c")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second == first {
+		t.Error("expected a freshly downloaded describe")
+	}
+}
+
+func TestDescribeCache_ServesStaleOnTransientError(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	server := &describeServer{etag: `"v1"`}
+	cache := newDescribeCacheForTest(server, &now)
+
+	first, _ := cache.DescribeGlobal(context.Background())
+	now = now.Add(2 * time.Minute)
+	server.failWith = http.StatusServiceUnavailable
+
+	stale, err := cache.DescribeGlobal(context.Background())
+	if err != nil {
+		t.Fatalf("expected stale entry, got error: %v", err)
+	}
+	if stale != first {
+		t.Error("expected the stale describe to be served")
+	}
+}
+
+func TestDescribeCache_StaleFallbackOnTransportErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantStale bool
+	}{
+		{"network failure", errors.New("connection reset by peer"), true},
+		{"deadline exceeded", context.DeadlineExceeded, false},
+		{"cancelled", context.Canceled, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+			server := &describeServer{etag: `"v1"`}
+			cache := newDescribeCacheForTest(server, &now)
+
+			first, _ := cache.DescribeGlobal(context.Background())
+			now = now.Add(2 * time.Minute)
+			server.failErr = tt.err
+
+			got, err := cache.DescribeGlobal(context.Background())
+			if tt.wantStale {
+				if err != nil || got != first {
+					t.Errorf("DescribeGlobal() = %v, %v; want the stale describe", got, err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("DescribeGlobal() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestDescribeCache_PropagatesPermanentError(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	server := &describeServer{etag: `"v1"`}
+	cache := newDescribeCacheForTest(server, &now)
+
+	_, _ = cache.DescribeSObject(context.Background(), "Nippou__c")
+	now = now.Add(2 * time.Minute)
+	server.failWith = http.StatusNotFound
+
+	if _, err := cache.DescribeSObject(context.Background(), "Nippou__c"); err == nil {
+		t.Fatal("expected not found error")
+	}
+
+	// The entry was dropped, so the next call is unconditional
+	server.failWith = 0
+	_, _ = cache.DescribeSObject(context.Background(), "Nippou__c")
+	if server.conditional != 0 {
+		t.Errorf("expected an unconditional refetch, got %d conditional requests", server.conditional)
+	}
+}
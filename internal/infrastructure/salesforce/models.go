@@ -14,6 +14,12 @@ import (
 const (
 	// NippouObjectName is the Salesforce custom object API name for Nippou.
 	NippouObjectName = "Nippou__c"
+	// NippouCommentObjectName is the child object holding comments on a Nippou__c.
+	NippouCommentObjectName = "NippouComment__c"
+	// NippouRevisionObjectName is the child object holding the revision log of a Nippou__c.
+	NippouRevisionObjectName = "NippouRevision__c"
+	// NippouEventObjectName is the platform event published when a Nippou__c changes.
+	NippouEventObjectName = "Nippou_Event__e"
 )
 
 // ============================================================================
@@ -34,7 +40,8 @@ type NippouSF struct {
 	Address   string  `json:"Address__c,omitempty"`    // Text(500)
 	VoiceOn   bool    `json:"VoiceEnabled__c"`         // Checkbox
 	VoiceModel string `json:"VoiceModel__c,omitempty"` // Text(100)
-	Tags      string  `json:"Tags__c,omitempty"`       // Long text (comma-separated)
+	Tags      string  `json:"Tags__c,omitempty"`       // Long text (comma-separated, legacy)
+	TagSet    string  `json:"TagSet__c,omitempty"`     // Multi-select picklist (semicolon-separated)
 
 	// Audit fields (read-only from SF)
 	CreatedDate      string `json:"CreatedDate,omitempty"`
@@ -54,7 +61,7 @@ func FromDomain(n *nippou.Nippou) *NippouSF {
 
 	sf := &NippouSF{
 		ID:      n.ID().String(),
-		Date:    n.Date().Format("2006-01-02"),
+		Date:    n.Date().String(),
 		Content: n.Content(),
 	}
 
@@ -71,8 +78,10 @@ func FromDomain(n *nippou.Nippou) *NippouSF {
 		sf.VoiceModel = voice.ModelName()
 	}
 
-	// Map tags as comma-separated string
+	// Map tags to the multi-select picklist, and dual-write the legacy
+	// comma-separated field until every reader has migrated
 	if tags := n.TagStrings(); len(tags) > 0 {
+		sf.TagSet = JoinMultiPicklist(tags)
 		sf.Tags = strings.Join(tags, ",")
 	}
 
@@ -104,15 +113,42 @@ func (sf *NippouSF) ToCreatePayload() map[string]interface{} {
 	if sf.Tags != "" {
 		payload["Tags__c"] = sf.Tags
 	}
+	if sf.TagSet != "" {
+		payload["TagSet__c"] = sf.TagSet
+	}
 
 	return payload
 }
 
 // ToUpdatePayload returns the NippouSF structure suitable for PATCH (update).
-// Excludes ID, audit fields, and unchanged fields for efficiency.
+// Excludes ID and audit fields. Every writable field is sent, with an explicit
+// null for empty ones: PATCH leaves omitted fields untouched, so omitting a
+// removed location or tag list would keep the old values.
 func (sf *NippouSF) ToUpdatePayload() map[string]interface{} {
-	// For simplicity, we update all fields (Salesforce handles null gracefully)
-	return sf.ToCreatePayload()
+	payload := map[string]interface{}{
+		"Date__c":         nullIfEmpty(sf.Date),
+		"Content__c":      nullIfEmpty(sf.Content),
+		"Latitude__c":     nil,
+		"Longitude__c":    nil,
+		"Address__c":      nullIfEmpty(sf.Address),
+		"VoiceEnabled__c": sf.VoiceOn,
+		"VoiceModel__c":   nullIfEmpty(sf.VoiceModel),
+		"Tags__c":         nullIfEmpty(sf.Tags),
+		"TagSet__c":       nullIfEmpty(sf.TagSet),
+	}
+	if sf.Latitude != 0 || sf.Longitude != 0 {
+		payload["Latitude__c"] = sf.Latitude
+		payload["Longitude__c"] = sf.Longitude
+	}
+	return payload
+}
+
+// nullIfEmpty returns nil for an empty string, which Salesforce stores as null.
+func nullIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
 }
 
 // ToDomain converts a Salesforce NippouSF to domain Nippou entity.
@@ -122,14 +158,16 @@ func (sf *NippouSF) ToDomain() (*nippou.Nippou, error) {
 		return nil, nil
 	}
 
-	// Parse date
-	date, err := time.Parse("2006-01-02", sf.Date)
+	// Parse date as a calendar date; never shift it through UTC
+	date, err := nippou.ParseCivilDate(sf.Date)
 	if err != nil {
-		// Try ISO 8601 format that Salesforce sometimes returns
-		date, err = time.Parse(time.RFC3339, sf.Date)
-		if err != nil {
+		// Try ISO 8601 format that Salesforce sometimes returns, keeping the
+		// calendar date in the offset the value was written with
+		t, tErr := time.Parse(time.RFC3339, sf.Date)
+		if tErr != nil {
 			return nil, err
 		}
+		date = nippou.CivilDateOf(t)
 	}
 
 	// Parse audit timestamps
@@ -160,15 +198,11 @@ func (sf *NippouSF) ToDomain() (*nippou.Nippou, error) {
 		}
 	}
 
-	// Parse tags from comma-separated string
-	var tags []string
-	if sf.Tags != "" {
-		for _, t := range strings.Split(sf.Tags, ",") {
-			trimmed := strings.TrimSpace(t)
-			if trimmed != "" {
-				tags = append(tags, trimmed)
-			}
-		}
+	// Parse tags from the multi-select picklist, falling back to the legacy
+	// comma-separated field for records that have not been migrated yet
+	tags := SplitMultiPicklist(sf.TagSet)
+	if len(tags) == 0 {
+		tags = SplitLegacyTags(sf.Tags)
 	}
 
 	// Use Reconstruct to create domain entity from stored data
@@ -196,6 +230,12 @@ type QueryResult struct {
 	Records        []NippouSF  `json:"records"`
 }
 
+// SearchResult represents a SOSL search response from Salesforce.
+// Only Nippou__c is requested in RETURNING, so every record maps to NippouSF.
+type SearchResult struct {
+	SearchRecords []NippouSF `json:"searchRecords"`
+}
+
 // ============================================================================
 // Helper Functions
 // ============================================================================
@@ -211,8 +251,8 @@ func parseTimestamp(s string) time.Time {
 		return t
 	}
 
-	// Try ISO 8601 without timezone
-	if t, err := time.Parse("2006-01-02T15:04:05.000+0000", s); err == nil {
+	// Try Salesforce's ISO 8601 form with a numeric offset (+0000, +0900)
+	if t, err := time.Parse("2006-01-02T15:04:05.000-0700", s); err == nil {
 		return t
 	}
 
@@ -224,14 +264,30 @@ func parseTimestamp(s string) time.Time {
 	return time.Time{}
 }
 
-// EscapeSOQL escapes special characters in SOQL string literals.
-// This prevents SOQL injection attacks.
-func EscapeSOQL(s string) string {
-	// Escape single quotes by doubling them
-	s = strings.ReplaceAll(s, "'", "''")
-	// Escape backslashes
-	s = strings.ReplaceAll(s, "\\", "\\\\")
-	return s
+// JoinMultiPicklist joins values into a multi-select picklist value.
+func JoinMultiPicklist(values []string) string {
+	return strings.Join(values, ";")
+}
+
+// SplitMultiPicklist splits a multi-select picklist value into its entries.
+func SplitMultiPicklist(s string) []string {
+	return splitNonEmpty(s, ";")
+}
+
+// SplitLegacyTags splits the legacy comma-separated Tags__c value.
+func SplitLegacyTags(s string) []string {
+	return splitNonEmpty(s, ",")
+}
+
+// splitNonEmpty splits s by sep, trimming entries and dropping empty ones.
+func splitNonEmpty(s, sep string) []string {
+	var result []string
+	for _, part := range strings.Split(s, sep) {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
 }
 
 // FormatDateForSOQL formats a Go time.Time for SOQL queries.
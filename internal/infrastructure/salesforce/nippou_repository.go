@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"time"
 
 	"salesforce-mcp-server/internal/domain/nippou"
 )
@@ -35,6 +34,7 @@ func (e *RepositoryError) Unwrap() error {
 type NippouRepository struct {
 	client *Client
 	ctx    context.Context
+	schema *SchemaChecker
 }
 
 // NewNippouRepository creates a new NippouRepository with the given Salesforce client.
@@ -58,6 +58,17 @@ func (r *NippouRepository) WithContext(ctx context.Context) *NippouRepository {
 	return &NippouRepository{
 		client: r.client,
 		ctx:    ctx,
+		schema: r.schema,
+	}
+}
+
+// WithSchemaCheck returns a new repository that verifies the Nippou__c schema
+// with checker before its first write.
+func (r *NippouRepository) WithSchemaCheck(checker *SchemaChecker) *NippouRepository {
+	return &NippouRepository{
+		client: r.client,
+		ctx:    r.ctx,
+		schema: checker,
 	}
 }
 
@@ -77,13 +88,16 @@ func (r *NippouRepository) FindByID(id nippou.ID) (*nippou.Nippou, error) {
 
 	// Query by ID using SOQL for consistency
 	// (Salesforce ID != our UUID, so we store our ID in a custom field or use it as external ID)
-	soql := fmt.Sprintf(
-		"SELECT Id, Date__c, Content__c, Latitude__c, Longitude__c, Address__c, "+
-			"VoiceEnabled__c, VoiceModel__c, Tags__c, CreatedDate, LastModifiedDate "+
-			"FROM %s WHERE Id = '%s' LIMIT 1",
-		NippouObjectName,
-		EscapeSOQL(id.String()),
-	)
+	soql, err := r.selectNippou().
+		Where(nippouIDCondition(id)).
+		Limit(1).
+		Build()
+	if err != nil {
+		return nil, &RepositoryError{
+			Operation: "FindByID",
+			Cause:     err,
+		}
+	}
 
 	var result QueryResult
 	if err := r.client.Query(r.ctx, url.QueryEscape(soql), &result); err != nil {
@@ -113,18 +127,12 @@ func (r *NippouRepository) FindByID(id nippou.ID) (*nippou.Nippou, error) {
 }
 
 // FindByDate retrieves all Nippou entries for a specific date.
-func (r *NippouRepository) FindByDate(date time.Time) ([]*nippou.Nippou, error) {
-	dateStr := FormatDateForSOQL(date)
-
-	soql := fmt.Sprintf(
-		"SELECT Id, Date__c, Content__c, Latitude__c, Longitude__c, Address__c, "+
-			"VoiceEnabled__c, VoiceModel__c, Tags__c, CreatedDate, LastModifiedDate "+
-			"FROM %s WHERE Date__c = %s ORDER BY CreatedDate ASC",
-		NippouObjectName,
-		dateStr,
-	)
-
-	return r.executeQuery(soql, "FindByDate")
+func (r *NippouRepository) FindByDate(date nippou.CivilDate) ([]*nippou.Nippou, error) {
+	query := r.selectNippou().
+		Where(Eq("Date__c", CivilDateLiteral(date))).
+		OrderByAsc("CreatedDate")
+
+	return r.executeQuery(query, "FindByDate")
 }
 
 // ============================================================================
@@ -152,6 +160,16 @@ func (r *NippouRepository) Save(n *nippou.Nippou) error {
 		}
 	}
 
+	// Verify the schema before the first write so mismatches surface clearly
+	if r.schema != nil {
+		if err := r.schema.VerifyOnce(r.ctx); err != nil {
+			return &RepositoryError{
+				Operation: "Save",
+				Cause:     err,
+			}
+		}
+	}
+
 	// Try to find existing record first
 	existing, err := r.FindByID(n.ID())
 	if err != nil {
@@ -172,8 +190,15 @@ func (r *NippouRepository) Save(n *nippou.Nippou) error {
 
 // Delete removes a Nippou by its ID.
 func (r *NippouRepository) Delete(id nippou.ID) error {
+	_, err := r.DeleteIfExists(id)
+	return err
+}
+
+// DeleteIfExists removes a Nippou by its ID and reports whether the record
+// existed. A missing record is not an error.
+func (r *NippouRepository) DeleteIfExists(id nippou.ID) (bool, error) {
 	if id.IsEmpty() {
-		return &RepositoryError{
+		return false, &RepositoryError{
 			Operation: "Delete",
 			Cause:     fmt.Errorf("empty ID provided"),
 		}
@@ -183,15 +208,15 @@ func (r *NippouRepository) Delete(id nippou.ID) error {
 	if err != nil {
 		// Not found is not an error for delete operations
 		if apiErr, ok := err.(*APIError); ok && apiErr.IsNotFound() {
-			return nil
+			return false, nil
 		}
-		return &RepositoryError{
+		return false, &RepositoryError{
 			Operation: "Delete",
 			Cause:     err,
 		}
 	}
 
-	return nil
+	return true, nil
 }
 
 // ============================================================================
@@ -199,42 +224,146 @@ func (r *NippouRepository) Delete(id nippou.ID) error {
 // ============================================================================
 
 // FindByDateRange retrieves all Nippou entries within a date range.
-func (r *NippouRepository) FindByDateRange(startDate, endDate time.Time) ([]*nippou.Nippou, error) {
-	startStr := FormatDateForSOQL(startDate)
-	endStr := FormatDateForSOQL(endDate)
-
-	soql := fmt.Sprintf(
-		"SELECT Id, Date__c, Content__c, Latitude__c, Longitude__c, Address__c, "+
-			"VoiceEnabled__c, VoiceModel__c, Tags__c, CreatedDate, LastModifiedDate "+
-			"FROM %s WHERE Date__c >= %s AND Date__c <= %s ORDER BY Date__c ASC, CreatedDate ASC",
-		NippouObjectName,
-		startStr,
-		endStr,
-	)
-
-	return r.executeQuery(soql, "FindByDateRange")
+func (r *NippouRepository) FindByDateRange(startDate, endDate nippou.CivilDate) ([]*nippou.Nippou, error) {
+	query := r.selectNippou().
+		Where(
+			Gte("Date__c", CivilDateLiteral(startDate)),
+			Lte("Date__c", CivilDateLiteral(endDate)),
+		).
+		OrderByAsc("Date__c").
+		OrderByAsc("CreatedDate")
+
+	return r.executeQuery(query, "FindByDateRange")
 }
 
-// FindByTag retrieves all Nippou entries that contain a specific tag.
+// TagMatch selects how FindByTags combines multiple tags.
+type TagMatch int
+
+const (
+	// TagMatchAny matches reports having at least one of the tags.
+	TagMatchAny TagMatch = iota
+	// TagMatchAll matches reports having every one of the tags.
+	TagMatchAll
+)
+
+// FindByTag retrieves all Nippou entries that have exactly the given tag.
 func (r *NippouRepository) FindByTag(tag string) ([]*nippou.Nippou, error) {
-	// Using LIKE for substring match in comma-separated tags
-	escapedTag := EscapeSOQL(tag)
-
-	soql := fmt.Sprintf(
-		"SELECT Id, Date__c, Content__c, Latitude__c, Longitude__c, Address__c, "+
-			"VoiceEnabled__c, VoiceModel__c, Tags__c, CreatedDate, LastModifiedDate "+
-			"FROM %s WHERE Tags__c LIKE '%%%s%%' ORDER BY CreatedDate DESC",
-		NippouObjectName,
-		escapedTag,
-	)
-
-	return r.executeQuery(soql, "FindByTag")
+	return r.findByTags([]string{tag}, TagMatchAny, "FindByTag")
+}
+
+// FindByTags retrieves all Nippou entries having any or all of the given tags.
+// Tags are normalized like nippou.ParseTag and matched exactly via INCLUDES.
+func (r *NippouRepository) FindByTags(tags []string, match TagMatch) ([]*nippou.Nippou, error) {
+	return r.findByTags(tags, match, "FindByTags")
+}
+
+// findByTags builds the INCLUDES query shared by FindByTag and FindByTags.
+func (r *NippouRepository) findByTags(tags []string, match TagMatch, operation string) ([]*nippou.Nippou, error) {
+	if len(tags) == 0 {
+		return nil, &RepositoryError{
+			Operation: operation,
+			Cause:     fmt.Errorf("no tags provided"),
+		}
+	}
+
+	condition, err := tagCondition(tags, match)
+	if err != nil {
+		return nil, &RepositoryError{
+			Operation: operation,
+			Cause:     err,
+		}
+	}
+
+	query := r.selectNippou().
+		Where(condition).
+		OrderByDesc("CreatedDate")
+
+	return r.executeQuery(query, operation)
+}
+
+// SearchFilters narrows a free-text Search. Zero values mean "no filter".
+type SearchFilters struct {
+	StartDate nippou.CivilDate // Inclusive lower bound on Date__c
+	EndDate   nippou.CivilDate // Inclusive upper bound on Date__c
+	Tags      []string         // Tags to match, normalized like nippou.ParseTag
+	TagMatch  TagMatch         // How multiple Tags are combined
+	Limit     int              // Maximum results (0 = Salesforce default, max MaxSOSLLimit)
+}
+
+// Search finds Nippou entries whose fields contain query, using SOSL
+// "FIND {query} IN ALL FIELDS". Results are in relevance order. The query is
+// matched literally (see NewSearch): SOSL operators, wildcards and boolean
+// keywords in it do not change the search.
+func (r *NippouRepository) Search(query string, filters SearchFilters) ([]*nippou.Nippou, error) {
+	returning := r.selectNippou()
+	if !filters.StartDate.IsZero() {
+		returning.Where(Gte("Date__c", CivilDateLiteral(filters.StartDate)))
+	}
+	if !filters.EndDate.IsZero() {
+		returning.Where(Lte("Date__c", CivilDateLiteral(filters.EndDate)))
+	}
+	if len(filters.Tags) > 0 {
+		condition, err := tagCondition(filters.Tags, filters.TagMatch)
+		if err != nil {
+			return nil, &RepositoryError{
+				Operation: "Search",
+				Cause:     err,
+			}
+		}
+		returning.Where(condition)
+	}
+	if filters.Limit < 0 || filters.Limit > MaxSOSLLimit {
+		return nil, &RepositoryError{
+			Operation: "Search",
+			Cause:     &SOQLError{Clause: "LIMIT", Message: fmt.Sprintf("must be between 0 and %d", MaxSOSLLimit)},
+		}
+	}
+	if filters.Limit > 0 {
+		returning.Limit(filters.Limit)
+	}
+
+	sosl, err := NewSearch(query).In(SearchAllFields).Returning(returning).Build()
+	if err != nil {
+		return nil, &RepositoryError{
+			Operation: "Search",
+			Cause:     err,
+		}
+	}
+
+	var result SearchResult
+	if err := r.client.Search(r.ctx, url.QueryEscape(sosl), &result); err != nil {
+		return nil, &RepositoryError{
+			Operation: "Search",
+			Cause:     err,
+		}
+	}
+
+	return toDomainList(result.SearchRecords), nil
 }
 
 // ============================================================================
 // Internal Helper Methods
 // ============================================================================
 
+// tagCondition builds the TagSet__c INCLUDES condition for tags, normalizing
+// each with nippou.ParseTag so that reserved words still find the reports
+// tagged with them before they were reserved.
+func tagCondition(tags []string, match TagMatch) (Condition, error) {
+	values := make([]string, 0, len(tags))
+	for _, tagStr := range tags {
+		tag, err := nippou.ParseTag(tagStr)
+		if err != nil {
+			return Condition{}, err
+		}
+		values = append(values, tag.String())
+	}
+
+	if match == TagMatchAll {
+		return IncludesAll("TagSet__c", values...), nil
+	}
+	return Includes("TagSet__c", values...), nil
+}
+
 // create inserts a new record in Salesforce.
 func (r *NippouRepository) create(sf *NippouSF) error {
 	payload := sf.ToCreatePayload()
@@ -272,8 +401,29 @@ func (r *NippouRepository) update(sfID string, sf *NippouSF) error {
 	return nil
 }
 
-// executeQuery runs a SOQL query and converts results to domain entities.
-func (r *NippouRepository) executeQuery(soql, operation string) ([]*nippou.Nippou, error) {
+// nippouIDCondition matches the Nippou__c record holding the domain ID id.
+func nippouIDCondition(id nippou.ID) Condition {
+	return Eq("Id", StringLiteral(id.String()))
+}
+
+// nippouFields is the SELECT list for Nippou__c, derived from NippouSF.
+var nippouFields = SOQLFields(NippouSF{})
+
+// selectNippou starts a query selecting every NippouSF field.
+func (r *NippouRepository) selectNippou() *QueryBuilder {
+	return NewQuery(NippouObjectName).Select(nippouFields...)
+}
+
+// executeQuery builds and runs a SOQL query and converts results to domain entities.
+func (r *NippouRepository) executeQuery(query *QueryBuilder, operation string) ([]*nippou.Nippou, error) {
+	soql, err := query.Build()
+	if err != nil {
+		return nil, &RepositoryError{
+			Operation: operation,
+			Cause:     err,
+		}
+	}
+
 	var result QueryResult
 	if err := r.client.Query(r.ctx, url.QueryEscape(soql), &result); err != nil {
 		return nil, &RepositoryError{
@@ -282,8 +432,13 @@ func (r *NippouRepository) executeQuery(soql, operation string) ([]*nippou.Nippo
 		}
 	}
 
-	nippous := make([]*nippou.Nippou, 0, len(result.Records))
-	for _, record := range result.Records {
+	return toDomainList(result.Records), nil
+}
+
+// toDomainList converts records to domain entities, skipping any that fail.
+func toDomainList(records []NippouSF) []*nippou.Nippou {
+	nippous := make([]*nippou.Nippou, 0, len(records))
+	for _, record := range records {
 		n, err := record.ToDomain()
 		if err != nil {
 			// Log warning but continue with other records
@@ -291,8 +446,7 @@ func (r *NippouRepository) executeQuery(soql, operation string) ([]*nippou.Nippo
 		}
 		nippous = append(nippous, n)
 	}
-
-	return nippous, nil
+	return nippous
 }
 
 // ============================================================================
@@ -301,3 +455,9 @@ func (r *NippouRepository) executeQuery(soql, operation string) ([]*nippou.Nippo
 
 // Ensure NippouRepository implements nippou.Repository at compile time.
 var _ nippou.Repository = (*NippouRepository)(nil)
+
+// Ensure NippouRepository implements the extended query interfaces.
+var (
+	_ nippou.DateRangeReader = (*NippouRepository)(nil)
+	_ nippou.TagReader       = (*NippouRepository)(nil)
+)
@@ -0,0 +1,71 @@
+package salesforce
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// NippouRepository.Save Tests
+// ============================================================================
+
+func TestNippouRepository_SaveClearsRemovedFields(t *testing.T) {
+	date, _ := nippou.ParseCivilDate("2024-01-15")
+	n, err := nippou.Reconstruct(nippou.ReconstructedNippou{
+		ID:      "550e8400-e29b-41d4-a716-446655440000",
+		Date:    date,
+		Content: "report",
+		Tags:    []string{"sales", "visit"},
+	})
+	if err != nil {
+		t.Fatalf("Reconstruct() error = %v", err)
+	}
+	loc, _ := nippou.NewLocation(35.68, 139.76, "東京都千代田区")
+	if err := n.SetLocation(loc); err != nil {
+		t.Fatalf("SetLocation() error = %v", err)
+	}
+	stored := *FromDomain(n)
+	if err := n.RemoveLocation(); err != nil {
+		t.Fatalf("RemoveLocation() error = %v", err)
+	}
+	for _, tag := range n.TagStrings() {
+		if err := n.RemoveTag(tag); err != nil {
+			t.Fatalf("RemoveTag() error = %v", err)
+		}
+	}
+
+	var patch map[string]interface{}
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			switch req.Method {
+			case http.MethodGet:
+				return newMockResponse(200, QueryResult{TotalSize: 1, Done: true, Records: []NippouSF{stored}}), nil
+			case http.MethodPatch:
+				body, _ := io.ReadAll(req.Body)
+				if err := json.Unmarshal(body, &patch); err != nil {
+					t.Errorf("PATCH body %s: %v", body, err)
+				}
+				return newMockResponse(http.StatusNoContent, nil), nil
+			}
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			return newMockResponse(500, nil), nil
+		},
+	}
+	if err := NewNippouRepository(newTestClient(mockHTTP)).Save(n); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	for _, field := range []string{"Latitude__c", "Longitude__c", "Address__c", "Tags__c", "TagSet__c"} {
+		value, ok := patch[field]
+		if !ok || value != nil {
+			t.Errorf("%s = %v (sent %v), want an explicit null", field, value, ok)
+		}
+	}
+	if patch["Content__c"] != "report" || patch["Date__c"] != "2024-01-15" {
+		t.Errorf("PATCH body = %v", patch)
+	}
+}
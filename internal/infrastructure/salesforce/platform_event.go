@@ -0,0 +1,419 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Nippou_Event__e - Salesforce Platform Event Mapping
+// ============================================================================
+
+// ChangeType tells subscribers what happened to the report.
+type ChangeType string
+
+const (
+	ChangeCreated ChangeType = "created"
+	ChangeUpdated ChangeType = "updated"
+	ChangeDeleted ChangeType = "deleted"
+)
+
+// NippouEventSF represents the Nippou_Event__e platform event published after
+// a report is saved or deleted. Deleted events carry only the ID.
+type NippouEventSF struct {
+	NippouID   string `json:"Nippou_Id__c"`      // Text(36)
+	Date       string `json:"Date__c,omitempty"` // Date in YYYY-MM-DD format
+	Tags       string `json:"Tags__c,omitempty"` // Text (semicolon-separated)
+	ChangeType string `json:"Change_Type__c"`    // created, updated or deleted
+}
+
+// NippouEventFromDomain builds the platform event for a saved Nippou.
+func NippouEventFromDomain(n *nippou.Nippou, change ChangeType) NippouEventSF {
+	return NippouEventSF{
+		NippouID:   n.ID().String(),
+		Date:       n.Date().String(),
+		Tags:       JoinMultiPicklist(n.TagStrings()),
+		ChangeType: string(change),
+	}
+}
+
+// changeTypeOf reports whether n was created or updated by its pending
+// events. Save runs before the use case drains them.
+func changeTypeOf(n *nippou.Nippou) ChangeType {
+	for _, e := range n.Events() {
+		if e.EventName() == nippou.EventNippouCreated {
+			return ChangeCreated
+		}
+	}
+	return ChangeUpdated
+}
+
+// ============================================================================
+// Outbox - Local Store for Failed Publishes
+// ============================================================================
+
+// PendingPublish is a platform event whose publish failed and awaits retry.
+type PendingPublish struct {
+	ID          string        `json:"id"`
+	Event       NippouEventSF `json:"event"`
+	Attempts    int           `json:"attempts"`
+	NextAttempt time.Time     `json:"nextAttempt"`
+	LastError   string        `json:"lastError,omitempty"`
+}
+
+// Outbox persists pending publishes so they survive restarts. Load and Store
+// work on the whole list; EventPublisher serializes access.
+type Outbox interface {
+	Load() ([]PendingPublish, error)
+	Store(pending []PendingPublish) error
+}
+
+// FileOutbox stores pending publishes as a JSON file.
+type FileOutbox struct {
+	path string
+}
+
+// NewFileOutbox creates an outbox backed by the file at path. The file and
+// its directory are created on first store.
+func NewFileOutbox(path string) *FileOutbox {
+	return &FileOutbox{path: path}
+}
+
+// Load reads the pending publishes; a missing file means none.
+func (o *FileOutbox) Load() ([]PendingPublish, error) {
+	data, err := os.ReadFile(o.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read outbox: %w", err)
+	}
+	var pending []PendingPublish
+	if err := json.Unmarshal(data, &pending); err != nil {
+		return nil, fmt.Errorf("decode outbox %s: %w", o.path, err)
+	}
+	return pending, nil
+}
+
+// Store replaces the file contents atomically, so a crash leaves either the
+// old or the new list.
+func (o *FileOutbox) Store(pending []PendingPublish) error {
+	if pending == nil {
+		pending = []PendingPublish{}
+	}
+	data, err := json.MarshalIndent(pending, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode outbox: %w", err)
+	}
+	if err := writeFileAtomic(o.path, data); err != nil {
+		return fmt.Errorf("write outbox: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it
+// into place, creating the directory if needed.
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ============================================================================
+// EventPublisher - Publishes Platform Events With Retry
+// ============================================================================
+
+// PublisherConfig holds the retry policy for failed publishes.
+type PublisherConfig struct {
+	RetryBaseDelay time.Duration    // Delay after the first failure; doubles per attempt
+	RetryMaxDelay  time.Duration    // Upper bound for the delay
+	Now            func() time.Time // Clock (useful for testing)
+}
+
+// DefaultPublisherConfig returns sensible default retry configuration.
+func DefaultPublisherConfig() *PublisherConfig {
+	return &PublisherConfig{
+		RetryBaseDelay: 30 * time.Second,
+		RetryMaxDelay:  time.Hour,
+		Now:            time.Now,
+	}
+}
+
+// EventPublisher publishes Nippou_Event__e records. A publish that fails is
+// written to the outbox and retried by RetryPending with exponential backoff
+// until it succeeds.
+type EventPublisher struct {
+	client  *Client
+	ctx     context.Context
+	outbox  Outbox
+	config  *PublisherConfig
+	onError func(event NippouEventSF, err error)
+	mu      *sync.Mutex // serializes outbox access, shared by WithContext copies
+}
+
+// NewEventPublisher creates a publisher that parks failed publishes in outbox.
+func NewEventPublisher(client *Client, outbox Outbox, config *PublisherConfig) *EventPublisher {
+	if config == nil {
+		config = DefaultPublisherConfig()
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &EventPublisher{
+		client: client,
+		ctx:    context.Background(),
+		outbox: outbox,
+		config: config,
+		mu:     &sync.Mutex{},
+	}
+}
+
+// WithContext returns a new publisher with the given context, sharing the outbox.
+func (p *EventPublisher) WithContext(ctx context.Context) *EventPublisher {
+	return &EventPublisher{
+		client:  p.client,
+		ctx:     ctx,
+		outbox:  p.outbox,
+		config:  p.config,
+		onError: p.onError,
+		mu:      p.mu,
+	}
+}
+
+// WithErrorHandler sets the callback for events that could be neither
+// published nor written to the outbox.
+func (p *EventPublisher) WithErrorHandler(fn func(event NippouEventSF, err error)) *EventPublisher {
+	p.onError = fn
+	return p
+}
+
+// Publish sends the event once. On failure it is queued in the outbox and
+// nil is returned; an error means the event could not be queued either.
+func (p *EventPublisher) Publish(event NippouEventSF) error {
+	err := p.send(p.ctx, event)
+	if err == nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pending, loadErr := p.outbox.Load()
+	if loadErr == nil {
+		pending = append(pending, PendingPublish{
+			ID:          nippou.NewID().String(),
+			Event:       event,
+			Attempts:    1,
+			NextAttempt: p.config.Now().Add(p.delay(1)),
+			LastError:   err.Error(),
+		})
+		loadErr = p.outbox.Store(pending)
+	}
+	if loadErr != nil {
+		storeErr := &RepositoryError{
+			Operation: "Publish",
+			Cause:     fmt.Errorf("%w (outbox: %v)", err, loadErr),
+		}
+		if p.onError != nil {
+			p.onError(event, storeErr)
+		}
+		return storeErr
+	}
+	return nil
+}
+
+// RetryPending publishes the outbox entries that are due and returns how many
+// were delivered. Failed entries are rescheduled with a longer delay.
+func (p *EventPublisher) RetryPending(ctx context.Context) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pending, err := p.outbox.Load()
+	if err != nil {
+		return 0, &RepositoryError{Operation: "RetryPending", Cause: err}
+	}
+
+	delivered := 0
+	remaining := make([]PendingPublish, 0, len(pending))
+	for i, entry := range pending {
+		if ctx.Err() != nil {
+			remaining = append(remaining, pending[i:]...)
+			break
+		}
+		if p.config.Now().Before(entry.NextAttempt) {
+			remaining = append(remaining, entry)
+			continue
+		}
+		if err := p.send(ctx, entry.Event); err != nil {
+			entry.Attempts++
+			entry.NextAttempt = p.config.Now().Add(p.delay(entry.Attempts))
+			entry.LastError = err.Error()
+			remaining = append(remaining, entry)
+			continue
+		}
+		delivered++
+	}
+
+	if err := p.outbox.Store(remaining); err != nil {
+		return delivered, &RepositoryError{Operation: "RetryPending", Cause: err}
+	}
+	return delivered, ctx.Err()
+}
+
+// Run calls RetryPending every interval until ctx is done.
+func (p *EventPublisher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, _ = p.RetryPending(ctx)
+		}
+	}
+}
+
+// send publishes event once (the client still retries transient errors).
+func (p *EventPublisher) send(ctx context.Context, event NippouEventSF) error {
+	result, err := p.client.CreateSObject(ctx, NippouEventObjectName, event)
+	if err != nil {
+		return err
+	}
+	if !result.Success {
+		return fmt.Errorf("Salesforce publish returned success=false")
+	}
+	return nil
+}
+
+// delay returns the backoff after the given number of failed attempts.
+func (p *EventPublisher) delay(attempts int) time.Duration {
+	d := p.config.RetryBaseDelay
+	for i := 1; i < attempts && d < p.config.RetryMaxDelay; i++ {
+		d *= 2
+	}
+	if p.config.RetryMaxDelay > 0 && d > p.config.RetryMaxDelay {
+		d = p.config.RetryMaxDelay
+	}
+	return d
+}
+
+// ============================================================================
+// PublishingNippouRepository - Decorator Publishing Platform Events
+// ============================================================================
+
+// PublishingNippouRepository wraps a nippou.Repository and publishes a
+// Nippou_Event__e after every successful Save or Delete.
+type PublishingNippouRepository struct {
+	next      nippou.Repository
+	publisher *EventPublisher
+}
+
+// NewPublishingNippouRepository wraps next so its writes are published.
+func NewPublishingNippouRepository(next nippou.Repository, publisher *EventPublisher) *PublishingNippouRepository {
+	return &PublishingNippouRepository{next: next, publisher: publisher}
+}
+
+// FindByID delegates to the wrapped repository.
+func (r *PublishingNippouRepository) FindByID(id nippou.ID) (*nippou.Nippou, error) {
+	return r.next.FindByID(id)
+}
+
+// FindByDate delegates to the wrapped repository.
+func (r *PublishingNippouRepository) FindByDate(date nippou.CivilDate) ([]*nippou.Nippou, error) {
+	return r.next.FindByDate(date)
+}
+
+// FindByDateRange delegates to the wrapped repository when it supports ranges.
+func (r *PublishingNippouRepository) FindByDateRange(startDate, endDate nippou.CivilDate) ([]*nippou.Nippou, error) {
+	ranger, ok := r.next.(nippou.DateRangeReader)
+	if !ok {
+		return nil, &RepositoryError{Operation: "FindByDateRange", Cause: errors.New("not supported by underlying repository")}
+	}
+	return ranger.FindByDateRange(startDate, endDate)
+}
+
+// FindByTag delegates to the wrapped repository when it supports tag queries.
+func (r *PublishingNippouRepository) FindByTag(tag string) ([]*nippou.Nippou, error) {
+	tagger, ok := r.next.(nippou.TagReader)
+	if !ok {
+		return nil, &RepositoryError{Operation: "FindByTag", Cause: errors.New("not supported by underlying repository")}
+	}
+	return tagger.FindByTag(tag)
+}
+
+// Save saves n and then publishes it as created or updated. A failed publish
+// is queued for retry and never fails the save.
+func (r *PublishingNippouRepository) Save(n *nippou.Nippou) error {
+	if err := r.next.Save(n); err != nil {
+		return err
+	}
+	_ = r.publisher.Publish(NippouEventFromDomain(n, changeTypeOf(n)))
+	return nil
+}
+
+// existenceDeleter is implemented by repositories whose Delete can report
+// whether the record existed, such as NippouRepository.
+type existenceDeleter interface {
+	DeleteIfExists(id nippou.ID) (bool, error)
+}
+
+// Delete deletes the record and then publishes a deleted event. Nothing is
+// published for a record that did not exist.
+func (r *PublishingNippouRepository) Delete(id nippou.ID) error {
+	existed, err := r.delete(id)
+	if err != nil || !existed {
+		return err
+	}
+	_ = r.publisher.Publish(NippouEventSF{NippouID: id.String(), ChangeType: string(ChangeDeleted)})
+	return nil
+}
+
+// delete deletes the record and reports whether it existed, looking it up
+// first when the wrapped repository cannot tell.
+func (r *PublishingNippouRepository) delete(id nippou.ID) (bool, error) {
+	if deleter, ok := r.next.(existenceDeleter); ok {
+		return deleter.DeleteIfExists(id)
+	}
+	existing, err := r.next.FindByID(id)
+	if err != nil {
+		return false, err
+	}
+	if existing == nil {
+		return false, nil
+	}
+	return true, r.next.Delete(id)
+}
+
+// ============================================================================
+// Compile-time Interface Compliance Check
+// ============================================================================
+
+var (
+	_ nippou.Repository      = (*PublishingNippouRepository)(nil)
+	_ nippou.DateRangeReader = (*PublishingNippouRepository)(nil)
+	_ nippou.TagReader       = (*PublishingNippouRepository)(nil)
+	_ Outbox                 = (*FileOutbox)(nil)
+	_ existenceDeleter       = (*NippouRepository)(nil)
+)
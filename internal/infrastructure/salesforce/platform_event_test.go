@@ -0,0 +1,224 @@
+package salesforce
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// stubNippouRepository is a minimal nippou.Repository for decorator tests.
+type stubNippouRepository struct {
+	saveErr error
+	saved   int
+	deleted int
+	missing bool // Delete finds no record
+}
+
+func (s *stubNippouRepository) FindByID(id nippou.ID) (*nippou.Nippou, error) { return nil, nil }
+func (s *stubNippouRepository) FindByDate(date nippou.CivilDate) ([]*nippou.Nippou, error) {
+	return nil, nil
+}
+func (s *stubNippouRepository) Save(n *nippou.Nippou) error {
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	s.saved++
+	return nil
+}
+func (s *stubNippouRepository) Delete(id nippou.ID) error {
+	_, err := s.DeleteIfExists(id)
+	return err
+}
+func (s *stubNippouRepository) DeleteIfExists(id nippou.ID) (bool, error) {
+	if s.missing {
+		return false, nil
+	}
+	s.deleted++
+	return true, nil
+}
+
+// publishRecorder serves Nippou_Event__e publishes, failing while down is set.
+type publishRecorder struct {
+	down      bool
+	published []map[string]interface{}
+}
+
+func (r *publishRecorder) client(t *testing.T) *Client {
+	t.Helper()
+	return newTestClient(&MockHTTPClient{DoFunc: func(req *http.Request) (*http.Response, error) {
+		if !strings.HasSuffix(req.URL.Path, "/sobjects/"+NippouEventObjectName) || req.Method != http.MethodPost {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		if r.down {
+			return newMockResponse(http.StatusServiceUnavailable, []sfErrorResponse{{Message: "maintenance", ErrorCode: "SERVER_UNAVAILABLE"}}), nil
+		}
+		body, _ := io.ReadAll(req.Body)
+		var payload map[string]interface{}
+		_ = json.Unmarshal(body, &payload)
+		r.published = append(r.published, payload)
+		return newMockResponse(http.StatusCreated, CreateSObjectResult{ID: "e00xx0000000001", Success: true}), nil
+	}})
+}
+
+func TestPublishingNippouRepository_PublishesChanges(t *testing.T) {
+	rec := &publishRecorder{}
+	outbox := NewFileOutbox(filepath.Join(t.TempDir(), "outbox.json"))
+	repo := NewPublishingNippouRepository(&stubNippouRepository{}, NewEventPublisher(rec.client(t), outbox, nil))
+
+	n, _ := nippou.NewNippou("2024-01-15", "report")
+	_ = n.AddTag("sales")
+	_ = n.AddTag("visit")
+	if err := repo.Save(n); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	n.PullEvents()
+	_ = n.UpdateContent("revised")
+	if err := repo.Save(n); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if err := repo.Delete(n.ID()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if len(rec.published) != 3 {
+		t.Fatalf("published %d events, want 3", len(rec.published))
+	}
+	first := rec.published[0]
+	if first["Nippou_Id__c"] != n.ID().String() || first["Date__c"] != "2024-01-15" ||
+		first["Tags__c"] != "sales;visit" || first["Change_Type__c"] != "created" {
+		t.Errorf("created event = %v", first)
+	}
+	if got := rec.published[1]["Change_Type__c"]; got != "updated" {
+		t.Errorf("second Change_Type__c = %v, want updated", got)
+	}
+	deleted := rec.published[2]
+	if deleted["Change_Type__c"] != "deleted" || deleted["Nippou_Id__c"] != n.ID().String() {
+		t.Errorf("deleted event = %v", deleted)
+	}
+	if _, ok := deleted["Date__c"]; ok {
+		t.Errorf("deleted event should carry only the ID: %v", deleted)
+	}
+}
+
+func TestPublishingNippouRepository_SaveFailureDoesNotPublish(t *testing.T) {
+	rec := &publishRecorder{}
+	outbox := NewFileOutbox(filepath.Join(t.TempDir(), "outbox.json"))
+	repo := NewPublishingNippouRepository(&stubNippouRepository{saveErr: errors.New("timeout")}, NewEventPublisher(rec.client(t), outbox, nil))
+
+	n, _ := nippou.NewNippou("2024-01-15", "report")
+	if err := repo.Save(n); err == nil {
+		t.Fatal("Save() expected error")
+	}
+	if len(rec.published) != 0 {
+		t.Errorf("published %d events for a failed save", len(rec.published))
+	}
+}
+
+func TestPublishingNippouRepository_DeleteMissingDoesNotPublish(t *testing.T) {
+	rec := &publishRecorder{}
+	outbox := NewFileOutbox(filepath.Join(t.TempDir(), "outbox.json"))
+	repo := NewPublishingNippouRepository(&stubNippouRepository{missing: true}, NewEventPublisher(rec.client(t), outbox, nil))
+
+	if err := repo.Delete(nippou.NewID()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if len(rec.published) != 0 {
+		t.Errorf("published %d events for a record that never existed", len(rec.published))
+	}
+	if pending, _ := outbox.Load(); len(pending) != 0 {
+		t.Errorf("outbox = %v, want nothing queued", pending)
+	}
+}
+
+func TestEventPublisher_RetriesWithBackoff(t *testing.T) {
+	rec := &publishRecorder{down: true}
+	now := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	outbox := NewFileOutbox(filepath.Join(t.TempDir(), "state", "outbox.json"))
+	publisher := NewEventPublisher(rec.client(t), outbox, &PublisherConfig{
+		RetryBaseDelay: time.Minute,
+		RetryMaxDelay:  3 * time.Minute,
+		Now:            func() time.Time { return now },
+	})
+	repo := NewPublishingNippouRepository(&stubNippouRepository{}, publisher)
+
+	n, _ := nippou.NewNippou("2024-01-15", "report")
+	if err := repo.Save(n); err != nil {
+		t.Fatalf("Save() error = %v, want the save to succeed while publishing is down", err)
+	}
+
+	pending, err := outbox.Load()
+	if err != nil || len(pending) != 1 {
+		t.Fatalf("outbox = %v, %v; want 1 pending publish", pending, err)
+	}
+	if pending[0].Attempts != 1 || !pending[0].NextAttempt.Equal(now.Add(time.Minute)) || pending[0].LastError == "" {
+		t.Errorf("pending = %+v", pending[0])
+	}
+
+	// Not yet due: nothing is attempted
+	if delivered, err := publisher.RetryPending(t.Context()); delivered != 0 || err != nil {
+		t.Errorf("RetryPending() = %d, %v before due", delivered, err)
+	}
+
+	// Due but still down: delay doubles, then caps
+	wantDelays := []time.Duration{2 * time.Minute, 3 * time.Minute, 3 * time.Minute}
+	for i, want := range wantDelays {
+		pending, _ = outbox.Load()
+		now = pending[0].NextAttempt
+		if delivered, _ := publisher.RetryPending(t.Context()); delivered != 0 {
+			t.Fatalf("retry %d delivered while down", i)
+		}
+		pending, _ = outbox.Load()
+		if got := pending[0].NextAttempt.Sub(now); got != want || pending[0].Attempts != i+2 {
+			t.Errorf("retry %d: delay = %v attempts = %d, want %v and %d", i, got, pending[0].Attempts, want, i+2)
+		}
+	}
+
+	// Back up: the event is delivered and the outbox emptied
+	rec.down = false
+	now = now.Add(time.Hour)
+	if delivered, err := publisher.RetryPending(t.Context()); delivered != 1 || err != nil {
+		t.Fatalf("RetryPending() = %d, %v, want 1 delivered", delivered, err)
+	}
+	if pending, _ = outbox.Load(); len(pending) != 0 {
+		t.Errorf("outbox still holds %d entries", len(pending))
+	}
+	if len(rec.published) != 1 || rec.published[0]["Change_Type__c"] != "created" {
+		t.Errorf("published = %v", rec.published)
+	}
+}
+
+func TestEventPublisher_SurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox.json")
+	down := &publishRecorder{down: true}
+	_ = NewEventPublisher(down.client(t), NewFileOutbox(path), nil).Publish(NippouEventSF{NippouID: "a", ChangeType: "created"})
+
+	up := &publishRecorder{}
+	restarted := NewEventPublisher(up.client(t), NewFileOutbox(path), &PublisherConfig{
+		Now: func() time.Time { return time.Now().Add(time.Hour) },
+	})
+	if delivered, err := restarted.RetryPending(t.Context()); delivered != 1 || err != nil {
+		t.Fatalf("RetryPending() = %d, %v, want 1 delivered", delivered, err)
+	}
+}
+
+func TestEventPublisher_OutboxFailure(t *testing.T) {
+	dir := t.TempDir()
+	rec := &publishRecorder{down: true}
+	var reported []error
+	// A directory in place of the outbox file cannot be read as JSON
+	publisher := NewEventPublisher(rec.client(t), NewFileOutbox(dir), nil).
+		WithErrorHandler(func(event NippouEventSF, err error) { reported = append(reported, err) })
+
+	err := publisher.Publish(NippouEventSF{NippouID: "a", ChangeType: "created"})
+	var repoErr *RepositoryError
+	if !errors.As(err, &repoErr) || len(reported) != 1 {
+		t.Errorf("Publish() error = %v, reported = %v", err, reported)
+	}
+}
@@ -0,0 +1,179 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// NippouRevision__c - Salesforce Custom Object Mapping
+// ============================================================================
+
+// NippouRevisionSF represents the Salesforce NippouRevision__c child object,
+// an append-only log of edits to a Nippou__c record.
+type NippouRevisionSF struct {
+	ID        string `json:"Id,omitempty"`
+	Nippou    string `json:"Nippou__c,omitempty"`        // Master-detail (Nippou__c)
+	Field     string `json:"Field__c,omitempty"`         // Picklist: content, tags, location
+	Author    string `json:"Author__c,omitempty"`        // Text(100)
+	Previous  string `json:"PreviousValue__c,omitempty"` // Long text area
+	Current   string `json:"NewValue__c,omitempty"`      // Long text area
+	ChangedAt string `json:"ChangedAt__c,omitempty"`     // DateTime
+}
+
+// RevisionFromDomain converts a domain Revision to NippouRevisionSF.
+func RevisionFromDomain(r *nippou.Revision) *NippouRevisionSF {
+	if r == nil {
+		return nil
+	}
+	return &NippouRevisionSF{
+		ID:        r.ID().String(),
+		Nippou:    r.NippouID().String(),
+		Field:     string(r.Field()),
+		Author:    r.Author(),
+		Previous:  r.Previous(),
+		Current:   r.Current(),
+		ChangedAt: r.ChangedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+// ToCreatePayload returns the fields sent when creating the record.
+// Empty values are sent explicitly so "added" and "removed" edits are kept.
+func (sf *NippouRevisionSF) ToCreatePayload() map[string]interface{} {
+	return map[string]interface{}{
+		"Nippou__c":        sf.Nippou,
+		"Field__c":         sf.Field,
+		"Author__c":        sf.Author,
+		"PreviousValue__c": sf.Previous,
+		"NewValue__c":      sf.Current,
+		"ChangedAt__c":     sf.ChangedAt,
+	}
+}
+
+// ToDomain converts a NippouRevisionSF to a domain Revision.
+func (sf *NippouRevisionSF) ToDomain() (*nippou.Revision, error) {
+	if sf == nil {
+		return nil, nil
+	}
+	return nippou.ReconstructRevision(nippou.ReconstructedRevision{
+		ID:        sf.ID,
+		NippouID:  sf.Nippou,
+		Field:     sf.Field,
+		Author:    sf.Author,
+		Previous:  sf.Previous,
+		Current:   sf.Current,
+		ChangedAt: parseTimestamp(sf.ChangedAt),
+	})
+}
+
+// RevisionQueryResult represents a SOQL query response for NippouRevision__c.
+type RevisionQueryResult struct {
+	TotalSize int                `json:"totalSize"`
+	Done      bool               `json:"done"`
+	Records   []NippouRevisionSF `json:"records"`
+}
+
+// ============================================================================
+// RevisionRepository - Implements domain.RevisionRepository
+// ============================================================================
+
+// RevisionRepository persists the revision log as NippouRevision__c records.
+type RevisionRepository struct {
+	client *Client
+	ctx    context.Context
+}
+
+// NewRevisionRepository creates a new RevisionRepository with the given Salesforce client.
+func NewRevisionRepository(client *Client) *RevisionRepository {
+	return &RevisionRepository{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// WithContext returns a new repository with the given context.
+func (r *RevisionRepository) WithContext(ctx context.Context) *RevisionRepository {
+	return &RevisionRepository{
+		client: r.client,
+		ctx:    ctx,
+	}
+}
+
+// SaveRevisions creates one NippouRevision__c record per revision, in order.
+// It stops at the first failure; earlier revisions stay recorded.
+func (r *RevisionRepository) SaveRevisions(revisions []*nippou.Revision) error {
+	for _, rev := range revisions {
+		if rev == nil {
+			continue
+		}
+		result, err := r.client.CreateSObject(r.ctx, NippouRevisionObjectName, RevisionFromDomain(rev).ToCreatePayload())
+		if err != nil {
+			return &RepositoryError{
+				Operation: "SaveRevisions",
+				Cause:     err,
+			}
+		}
+		if !result.Success {
+			return &RepositoryError{
+				Operation: "SaveRevisions",
+				Cause:     fmt.Errorf("Salesforce create returned success=false"),
+			}
+		}
+	}
+	return nil
+}
+
+// FindRevisions retrieves the revisions of a Nippou, oldest first.
+func (r *RevisionRepository) FindRevisions(nippouID nippou.ID) ([]*nippou.Revision, error) {
+	if nippouID.IsEmpty() {
+		return nil, &RepositoryError{
+			Operation: "FindRevisions",
+			Cause:     fmt.Errorf("empty ID provided"),
+		}
+	}
+
+	soql, err := NewQuery(NippouRevisionObjectName).
+		Select(revisionFields...).
+		Where(Eq("Nippou__c", StringLiteral(nippouID.String()))).
+		OrderByAsc("ChangedAt__c").
+		OrderByAsc("CreatedDate").
+		Build()
+	if err != nil {
+		return nil, &RepositoryError{
+			Operation: "FindRevisions",
+			Cause:     err,
+		}
+	}
+
+	var result RevisionQueryResult
+	if err := r.client.Query(r.ctx, url.QueryEscape(soql), &result); err != nil {
+		return nil, &RepositoryError{
+			Operation: "FindRevisions",
+			Cause:     err,
+		}
+	}
+
+	revisions := make([]*nippou.Revision, 0, len(result.Records))
+	for _, record := range result.Records {
+		rev, err := record.ToDomain()
+		if err != nil {
+			continue
+		}
+		revisions = append(revisions, rev)
+	}
+	return revisions, nil
+}
+
+// revisionFields is the SELECT list for NippouRevision__c, derived from NippouRevisionSF.
+var revisionFields = SOQLFields(NippouRevisionSF{})
+
+// ============================================================================
+// Compile-time Interface Compliance Check
+// ============================================================================
+
+// Ensure RevisionRepository implements nippou.RevisionRepository at compile time.
+var _ nippou.RevisionRepository = (*RevisionRepository)(nil)
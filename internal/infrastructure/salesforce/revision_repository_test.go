@@ -0,0 +1,81 @@
+package salesforce
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// RevisionRepository Tests
+// ============================================================================
+
+func TestRevisionRepository_SaveRevisions(t *testing.T) {
+	var payloads []map[string]interface{}
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if !strings.HasSuffix(req.URL.Path, "/sobjects/NippouRevision__c") {
+				t.Errorf("unexpected path: %s", req.URL.Path)
+			}
+			var payload map[string]interface{}
+			body, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &payload)
+			payloads = append(payloads, payload)
+			return newMockResponse(201, CreateSObjectResult{ID: "a0Y000000000001", Success: true}), nil
+		},
+	}
+	repo := NewRevisionRepository(newTestClient(mockHTTP))
+
+	nippouID, _ := nippou.IDFromString(testNippouID)
+	changedAt := time.Date(2024, 1, 15, 18, 30, 0, 0, time.FixedZone("JST", 9*3600))
+	content, _ := nippou.NewRevision(nippouID, "佐藤", nippou.Change{Field: nippou.RevisionContent, Previous: "a", Current: "b", ChangedAt: changedAt})
+	location, _ := nippou.NewRevision(nippouID, "佐藤", nippou.Change{Field: nippou.RevisionLocation, Previous: "35.0,139.0"})
+
+	if err := repo.SaveRevisions([]*nippou.Revision{content, location}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(payloads) != 2 {
+		t.Fatalf("expected 2 creates, got %d", len(payloads))
+	}
+	if p := payloads[0]; p["Field__c"] != "content" || p["PreviousValue__c"] != "a" || p["ChangedAt__c"] != "2024-01-15T09:30:00Z" {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	if p := payloads[1]; p["NewValue__c"] != "" {
+		t.Errorf("removal should send an empty new value: %v", p)
+	}
+}
+
+func TestRevisionRepository_FindRevisions(t *testing.T) {
+	var gotSOQL string
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			gotSOQL, _ = url.QueryUnescape(strings.TrimPrefix(req.URL.RawQuery, "q="))
+			return newMockResponse(200, RevisionQueryResult{TotalSize: 2, Done: true, Records: []NippouRevisionSF{
+				{ID: "550e8400-e29b-41d4-a716-446655440020", Nippou: testNippouID, Field: "tags", Author: "佐藤", Current: "sales", ChangedAt: "2024-01-15T09:30:00.000+0000"},
+				{ID: "550e8400-e29b-41d4-a716-446655440021", Nippou: testNippouID, Field: "voice"},
+			}}), nil
+		},
+	}
+	repo := NewRevisionRepository(newTestClient(mockHTTP))
+
+	nippouID, _ := nippou.IDFromString(testNippouID)
+	revisions, err := repo.FindRevisions(nippouID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "SELECT " + strings.Join(revisionFields, ", ") + " FROM NippouRevision__c WHERE Nippou__c = '" +
+		testNippouID + "' ORDER BY ChangedAt__c ASC, CreatedDate ASC"
+	if gotSOQL != expected {
+		t.Errorf("SOQL =\n  %s\nwant\n  %s", gotSOQL, expected)
+	}
+	if len(revisions) != 1 || revisions[0].Field() != nippou.RevisionTags || revisions[0].ChangedAt().IsZero() {
+		t.Errorf("unexpected revisions: %+v", revisions)
+	}
+}
@@ -0,0 +1,227 @@
+package salesforce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Field Requirements - What NippouSF Relies On
+// ============================================================================
+
+// FieldRequirement describes the minimum shape a field must have for the
+// mapping in models.go to work.
+type FieldRequirement struct {
+	Name      string   // Field API name, e.g. "Address__c"
+	Types     []string // Accepted describe types, e.g. "string", "textarea"
+	Length    int      // Minimum length for text fields (0 = not checked)
+	Precision int      // Total digits for numeric fields (0 = not checked)
+	Scale     int      // Fraction digits for numeric fields
+	Open      bool     // Picklist must accept values not defined in setup
+}
+
+// fieldTypeLabels maps describe types to the names used in Salesforce setup.
+var fieldTypeLabels = map[string]string{
+	"string":        "Text",
+	"textarea":      "LongTextArea",
+	"double":        "Number",
+	"date":          "Date",
+	"boolean":       "Checkbox",
+	"multipicklist": "Picklist (Multi-Select)",
+}
+
+// String renders the requirement in Salesforce setup terms, e.g. Text(500).
+func (r FieldRequirement) String() string {
+	label := ""
+	if len(r.Types) > 0 {
+		label = r.Types[0]
+		if l, ok := fieldTypeLabels[label]; ok {
+			label = l
+		}
+	}
+	switch {
+	case r.Precision > 0:
+		return fmt.Sprintf("Decimal(%d,%d)", r.Precision, r.Scale)
+	case r.Length > 0:
+		return fmt.Sprintf("%s(%d)", label, r.Length)
+	default:
+		return label
+	}
+}
+
+// maxTagsFieldLength is the longest comma-joined legacy Tags__c value FromDomain can produce.
+const maxTagsFieldLength = nippou.MaxTagCount*nippou.MaxTagLength + nippou.MaxTagCount - 1
+
+// NippouFieldRequirements lists the Nippou__c fields that NippouSF maps.
+var NippouFieldRequirements = []FieldRequirement{
+	{Name: "Date__c", Types: []string{"date"}},
+	{Name: "Content__c", Types: []string{"textarea"}, Length: nippou.MaxContentLength},
+	{Name: "Latitude__c", Types: []string{"double"}, Precision: 10, Scale: 7},
+	{Name: "Longitude__c", Types: []string{"double"}, Precision: 10, Scale: 7},
+	{Name: "Address__c", Types: []string{"string", "textarea"}, Length: nippou.MaxAddressLength},
+	{Name: "VoiceEnabled__c", Types: []string{"boolean"}},
+	{Name: "VoiceModel__c", Types: []string{"string"}, Length: nippou.MaxModelNameLength},
+	{Name: "Tags__c", Types: []string{"textarea"}, Length: maxTagsFieldLength},
+	{Name: "TagSet__c", Types: []string{"multipicklist"}, Open: true},
+}
+
+// ============================================================================
+// Schema Errors
+// ============================================================================
+
+// SchemaIssue is a single missing or mismatched field.
+type SchemaIssue struct {
+	Field   string
+	Message string
+}
+
+func (i SchemaIssue) String() string {
+	return i.Field + ": " + i.Message
+}
+
+// SchemaError reports every field of an sObject that does not match its requirements.
+type SchemaError struct {
+	Object string
+	Issues []SchemaIssue
+}
+
+func (e *SchemaError) Error() string {
+	lines := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		lines[i] = e.Object + "." + issue.String()
+	}
+	return fmt.Sprintf("schema mismatch on %s (%d issues): %s", e.Object, len(e.Issues), strings.Join(lines, "; "))
+}
+
+// ============================================================================
+// SchemaChecker - Startup Verification
+// ============================================================================
+
+// SObjectDescriber abstracts sObject describe (implemented by Client and DescribeCache).
+type SObjectDescriber interface {
+	DescribeSObject(ctx context.Context, objectName string) (*SObjectDescribe, error)
+}
+
+// SchemaChecker verifies that an sObject has the fields the mapping relies on.
+type SchemaChecker struct {
+	describer    SObjectDescriber
+	objectName   string
+	requirements []FieldRequirement
+
+	mu       sync.Mutex
+	verified bool
+	err      *SchemaError
+}
+
+// NewSchemaChecker creates a checker for objectName against requirements.
+func NewSchemaChecker(describer SObjectDescriber, objectName string, requirements []FieldRequirement) *SchemaChecker {
+	return &SchemaChecker{
+		describer:    describer,
+		objectName:   objectName,
+		requirements: requirements,
+	}
+}
+
+// NewNippouSchemaChecker creates a checker for Nippou__c against NippouFieldRequirements.
+func NewNippouSchemaChecker(describer SObjectDescriber) *SchemaChecker {
+	return NewSchemaChecker(describer, NippouObjectName, NippouFieldRequirements)
+}
+
+// Verify describes the sObject and returns a *SchemaError listing every
+// missing or mismatched field, or nil if the schema is compatible.
+func (c *SchemaChecker) Verify(ctx context.Context) error {
+	describe, err := c.describer.DescribeSObject(ctx, c.objectName)
+	if err != nil {
+		return fmt.Errorf("failed to describe %s: %w", c.objectName, err)
+	}
+	return CheckSchema(describe, c.objectName, c.requirements)
+}
+
+// VerifyOnce runs Verify until it yields a definitive outcome and returns the
+// cached outcome afterwards. Describe failures are not cached, so a transient
+// outage at startup does not block writes forever.
+func (c *SchemaChecker) VerifyOnce(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.verified {
+		return nil
+	}
+	if c.err != nil {
+		return c.err
+	}
+
+	err := c.Verify(ctx)
+	if err == nil {
+		c.verified = true
+		return nil
+	}
+	var schemaErr *SchemaError
+	if errors.As(err, &schemaErr) {
+		c.err = schemaErr
+	}
+	return err
+}
+
+// CheckSchema compares a describe result with requirements without any I/O.
+func CheckSchema(describe *SObjectDescribe, objectName string, requirements []FieldRequirement) error {
+	var issues []SchemaIssue
+	for _, req := range requirements {
+		field, ok := describe.Field(req.Name)
+		if !ok {
+			issues = append(issues, SchemaIssue{
+				Field:   req.Name,
+				Message: fmt.Sprintf("field is missing (expected %s)", req),
+			})
+			continue
+		}
+		issues = append(issues, checkField(req, field)...)
+	}
+	if len(issues) > 0 {
+		return &SchemaError{Object: objectName, Issues: issues}
+	}
+	return nil
+}
+
+// checkField returns the issues of a single existing field.
+func checkField(req FieldRequirement, field *FieldDescribe) []SchemaIssue {
+	var issues []SchemaIssue
+	add := func(format string, args ...interface{}) {
+		issues = append(issues, SchemaIssue{Field: req.Name, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if !containsString(req.Types, field.Type) {
+		add("expected type %s, got %s", strings.Join(req.Types, " or "), field.Type)
+		return issues
+	}
+	if req.Length > 0 && field.Length < req.Length {
+		add("expected length >= %d, got %d", req.Length, field.Length)
+	}
+	if req.Precision > 0 {
+		intDigits := field.Precision - field.Scale
+		if field.Scale < req.Scale || intDigits < req.Precision-req.Scale {
+			add("expected %s, got Decimal(%d,%d)", req, field.Precision, field.Scale)
+		}
+	}
+	if req.Open && field.Restricted {
+		add("picklist is restricted; new values would be rejected (disable \"Restrict picklist to the values defined\")")
+	}
+	if !field.Createable || !field.Updateable {
+		add("field is not createable/updateable (check field-level security)")
+	}
+	return issues
+}
+
+// containsString reports whether values contains s.
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package salesforce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Test Helpers
+// ============================================================================
+
+// validNippouFields returns a describe field list matching NippouFieldRequirements.
+func validNippouFields() []FieldDescribe {
+	writable := func(f FieldDescribe) FieldDescribe {
+		f.Custom, f.Createable, f.Updateable = true, true, true
+		return f
+	}
+	return []FieldDescribe{
+		{Name: "Id", Type: "id", Length: 18},
+		writable(FieldDescribe{Name: "Date__c", Type: "date"}),
+		writable(FieldDescribe{Name: "Content__c", Type: "textarea", Length: 131072}),
+		writable(FieldDescribe{Name: "Latitude__c", Type: "double", Precision: 10, Scale: 7}),
+		writable(FieldDescribe{Name: "Longitude__c", Type: "double", Precision: 10, Scale: 7}),
+		writable(FieldDescribe{Name: "Address__c", Type: "string", Length: 500}),
+		writable(FieldDescribe{Name: "VoiceEnabled__c", Type: "boolean"}),
+		writable(FieldDescribe{Name: "VoiceModel__c", Type: "string", Length: 100}),
+		writable(FieldDescribe{Name: "Tags__c", Type: "textarea", Length: 32768}),
+		writable(FieldDescribe{Name: "TagSet__c", Type: "multipicklist", Length: 4099}),
+	}
+}
+
+// stubDescriber returns a fixed describe result and counts calls.
+type stubDescriber struct {
+	describe *SObjectDescribe
+	err      error
+	calls    int
+}
+
+func (s *stubDescriber) DescribeSObject(ctx context.Context, objectName string) (*SObjectDescribe, error) {
+	s.calls++
+	return s.describe, s.err
+}
+
+// withField replaces the named field in fields.
+func withField(fields []FieldDescribe, f FieldDescribe) []FieldDescribe {
+	result := make([]FieldDescribe, 0, len(fields))
+	for _, existing := range fields {
+		if existing.Name == f.Name {
+			existing = f
+		}
+		result = append(result, existing)
+	}
+	return result
+}
+
+// withoutField removes the named field from fields.
+func withoutField(fields []FieldDescribe, name string) []FieldDescribe {
+	result := make([]FieldDescribe, 0, len(fields))
+	for _, existing := range fields {
+		if existing.Name != name {
+			result = append(result, existing)
+		}
+	}
+	return result
+}
+
+// ============================================================================
+// CheckSchema Tests
+// ============================================================================
+
+func TestCheckSchema_Valid(t *testing.T) {
+	describe := &SObjectDescribe{Name: NippouObjectName, Fields: validNippouFields()}
+	if err := CheckSchema(describe, NippouObjectName, NippouFieldRequirements); err != nil {
+		t.Fatalf("expected compatible schema, got %v", err)
+	}
+}
+
+func TestCheckSchema_ReportsEveryIssue(t *testing.T) {
+	fields := validNippouFields()
+	fields = withoutField(fields, "Tags__c")
+	fields = withField(fields, FieldDescribe{Name: "Address__c", Type: "string", Length: 255, Createable: true, Updateable: true})
+	fields = withField(fields, FieldDescribe{Name: "Latitude__c", Type: "double", Precision: 8, Scale: 5, Createable: true, Updateable: true})
+	fields = withField(fields, FieldDescribe{Name: "VoiceEnabled__c", Type: "string", Length: 5, Createable: true, Updateable: true})
+	fields = withField(fields, FieldDescribe{Name: "VoiceModel__c", Type: "string", Length: 100})
+	fields = withField(fields, FieldDescribe{Name: "TagSet__c", Type: "multipicklist", Restricted: true, Createable: true, Updateable: true})
+
+	err := CheckSchema(&SObjectDescribe{Fields: fields}, NippouObjectName, NippouFieldRequirements)
+
+	var schemaErr *SchemaError
+	if !errors.As(err, &schemaErr) {
+		t.Fatalf("expected SchemaError, got %T: %v", err, err)
+	}
+
+	expected := map[string]string{
+		"Tags__c":         "field is missing (expected LongTextArea(1019))",
+		"Address__c":      "expected length >= 500, got 255",
+		"Latitude__c":     "expected Decimal(10,7), got Decimal(8,5)",
+		"VoiceEnabled__c": "expected type boolean, got string",
+		"VoiceModel__c":   "field is not createable/updateable (check field-level security)",
+		"TagSet__c":       `picklist is restricted; new values would be rejected (disable "Restrict picklist to the values defined")`,
+	}
+	if len(schemaErr.Issues) != len(expected) {
+		t.Fatalf("expected %d issues, got %d: %v", len(expected), len(schemaErr.Issues), schemaErr.Issues)
+	}
+	for _, issue := range schemaErr.Issues {
+		if want := expected[issue.Field]; issue.Message != want {
+			t.Errorf("%s: got %q, want %q", issue.Field, issue.Message, want)
+		}
+	}
+	if !strings.Contains(err.Error(), "Nippou__c.Address__c: expected length >= 500, got 255") {
+		t.Errorf("error message lacks field detail: %v", err)
+	}
+}
+
+func TestCheckSchema_WiderFieldsAreAccepted(t *testing.T) {
+	fields := withField(validNippouFields(), FieldDescribe{
+		Name: "Longitude__c", Type: "double", Precision: 12, Scale: 9, Createable: true, Updateable: true,
+	})
+	fields = withField(fields, FieldDescribe{
+		Name: "Address__c", Type: "textarea", Length: 1000, Createable: true, Updateable: true,
+	})
+
+	if err := CheckSchema(&SObjectDescribe{Fields: fields}, NippouObjectName, NippouFieldRequirements); err != nil {
+		t.Fatalf("expected wider fields to be compatible, got %v", err)
+	}
+}
+
+// ============================================================================
+// SchemaChecker Tests
+// ============================================================================
+
+func TestSchemaChecker_VerifyOnce_CachesSchemaError(t *testing.T) {
+	describer := &stubDescriber{describe: &SObjectDescribe{Fields: withoutField(validNippouFields(), "Tags__c")}}
+	checker := NewNippouSchemaChecker(describer)
+
+	for i := 0; i < 2; i++ {
+		var schemaErr *SchemaError
+		if err := checker.VerifyOnce(context.Background()); !errors.As(err, &schemaErr) {
+			t.Fatalf("expected SchemaError, got %v", err)
+		}
+	}
+	if describer.calls != 1 {
+		t.Errorf("expected 1 describe call, got %d", describer.calls)
+	}
+}
+
+func TestSchemaChecker_VerifyOnce_RetriesDescribeFailure(t *testing.T) {
+	describer := &stubDescriber{err: &APIError{StatusCode: 503, Message: "unavailable"}}
+	checker := NewNippouSchemaChecker(describer)
+
+	if err := checker.VerifyOnce(context.Background()); err == nil {
+		t.Fatal("expected describe failure")
+	}
+
+	describer.err = nil
+	describer.describe = &SObjectDescribe{Fields: validNippouFields()}
+	if err := checker.VerifyOnce(context.Background()); err != nil {
+		t.Fatalf("expected success after recovery, got %v", err)
+	}
+	if describer.calls != 2 {
+		t.Errorf("expected 2 describe calls, got %d", describer.calls)
+	}
+}
+
+func TestNippouRepository_Save_SchemaMismatchBlocksWrite(t *testing.T) {
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			t.Errorf("no request expected, got %s %s", req.Method, req.URL.Path)
+			return newMockResponse(500, nil), nil
+		},
+	}
+	describer := &stubDescriber{describe: &SObjectDescribe{Fields: withoutField(validNippouFields(), "Tags__c")}}
+	repo := NewNippouRepository(newTestClient(mockHTTP)).WithSchemaCheck(NewNippouSchemaChecker(describer))
+
+	n, err := nippou.NewNippou("2024-01-15", "Test content")
+	if err != nil {
+		t.Fatalf("failed to create Nippou: %v", err)
+	}
+
+	err = repo.Save(n)
+	var schemaErr *SchemaError
+	if !errors.As(err, &schemaErr) {
+		t.Fatalf("expected SchemaError, got %v", err)
+	}
+}
@@ -0,0 +1,463 @@
+package salesforce
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// SOQL Errors
+// ============================================================================
+
+// SOQLError represents an invalid SOQL query construction.
+type SOQLError struct {
+	Clause  string
+	Message string
+}
+
+func (e *SOQLError) Error() string {
+	return fmt.Sprintf("invalid SOQL %s: %s", e.Clause, e.Message)
+}
+
+// ============================================================================
+// Field Lists - Derived from Mapping Structs
+// ============================================================================
+
+// SOQLFields returns the field API names of a mapping struct such as NippouSF,
+// taken from its json tags in declaration order. Fields tagged "-" are skipped.
+func SOQLFields(v interface{}) []string {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	fields := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
+// ============================================================================
+// Literals - Safe Value Encoding
+// ============================================================================
+
+// Literal is an encoded SOQL value. Literals can only be created through the
+// constructors below, so every value embedded in a query is properly encoded.
+type Literal struct {
+	text string
+}
+
+// String returns the encoded literal text.
+func (l Literal) String() string {
+	return l.text
+}
+
+// StringLiteral encodes s as a quoted, escaped SOQL string literal.
+func StringLiteral(s string) Literal {
+	return Literal{text: "'" + EscapeSOQL(s) + "'"}
+}
+
+// DateLiteral encodes the calendar date of t (YYYY-MM-DD, unquoted).
+func DateLiteral(t time.Time) Literal {
+	return Literal{text: FormatDateForSOQL(t)}
+}
+
+// CivilDateLiteral encodes a calendar date (YYYY-MM-DD, unquoted).
+func CivilDateLiteral(d nippou.CivilDate) Literal {
+	return Literal{text: d.String()}
+}
+
+// DateTimeLiteral encodes t as a UTC SOQL dateTime (unquoted).
+func DateTimeLiteral(t time.Time) Literal {
+	return Literal{text: t.UTC().Format("2006-01-02T15:04:05Z")}
+}
+
+// IntLiteral encodes an integer.
+func IntLiteral(n int) Literal {
+	return Literal{text: strconv.Itoa(n)}
+}
+
+// FloatLiteral encodes a decimal number.
+func FloatLiteral(f float64) Literal {
+	return Literal{text: strconv.FormatFloat(f, 'f', -1, 64)}
+}
+
+// BoolLiteral encodes a boolean.
+func BoolLiteral(b bool) Literal {
+	return Literal{text: strconv.FormatBool(b)}
+}
+
+// NullLiteral encodes null.
+func NullLiteral() Literal {
+	return Literal{text: "null"}
+}
+
+// ============================================================================
+// Conditions - Typed WHERE Clauses
+// ============================================================================
+
+// Condition is a WHERE clause expression. Construction errors (such as an
+// invalid field name) are carried along and reported by QueryBuilder.Build.
+type Condition struct {
+	expr string
+	err  error
+}
+
+// soqlFieldPattern matches field names and relationship paths (Owner.Name).
+var soqlFieldPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*(\.[A-Za-z][A-Za-z0-9_]*)*$`)
+
+// validateField checks that field is a well-formed field name or path.
+func validateField(clause, field string) error {
+	if !soqlFieldPattern.MatchString(field) {
+		return &SOQLError{Clause: clause, Message: fmt.Sprintf("invalid field name %q", field)}
+	}
+	return nil
+}
+
+// compare builds "field op literal".
+func compare(field, op string, value Literal) Condition {
+	if err := validateField("WHERE", field); err != nil {
+		return Condition{err: err}
+	}
+	if value.text == "" {
+		return Condition{err: &SOQLError{Clause: "WHERE", Message: "zero Literal for " + field}}
+	}
+	return Condition{expr: field + " " + op + " " + value.text}
+}
+
+// Eq builds "field = value".
+func Eq(field string, value Literal) Condition { return compare(field, "=", value) }
+
+// Ne builds "field != value".
+func Ne(field string, value Literal) Condition { return compare(field, "!=", value) }
+
+// Gt builds "field > value".
+func Gt(field string, value Literal) Condition { return compare(field, ">", value) }
+
+// Gte builds "field >= value".
+func Gte(field string, value Literal) Condition { return compare(field, ">=", value) }
+
+// Lt builds "field < value".
+func Lt(field string, value Literal) Condition { return compare(field, "<", value) }
+
+// Lte builds "field <= value".
+func Lte(field string, value Literal) Condition { return compare(field, "<=", value) }
+
+// In builds "field IN (v1, v2, ...)". At least one value is required.
+func In(field string, values ...Literal) Condition {
+	return setCondition(field, "IN", values)
+}
+
+// NotIn builds "field NOT IN (v1, v2, ...)". At least one value is required.
+func NotIn(field string, values ...Literal) Condition {
+	return setCondition(field, "NOT IN", values)
+}
+
+// setCondition builds "field op (values)".
+func setCondition(field, op string, values []Literal) Condition {
+	if err := validateField("WHERE", field); err != nil {
+		return Condition{err: err}
+	}
+	if len(values) == 0 {
+		return Condition{err: &SOQLError{Clause: "WHERE", Message: op + " requires at least one value for " + field}}
+	}
+	encoded := make([]string, len(values))
+	for i, v := range values {
+		if v.text == "" {
+			return Condition{err: &SOQLError{Clause: "WHERE", Message: "zero Literal for " + field}}
+		}
+		encoded[i] = v.text
+	}
+	return Condition{expr: field + " " + op + " (" + strings.Join(encoded, ", ") + ")"}
+}
+
+// Contains builds "field LIKE '%substr%'" with LIKE wildcards in substr escaped.
+func Contains(field, substr string) Condition {
+	return likeCondition(field, "%"+EscapeSOQLLike(substr)+"%")
+}
+
+// StartsWith builds "field LIKE 'prefix%'" with LIKE wildcards in prefix escaped.
+func StartsWith(field, prefix string) Condition {
+	return likeCondition(field, EscapeSOQLLike(prefix)+"%")
+}
+
+// EndsWith builds "field LIKE '%suffix'" with LIKE wildcards in suffix escaped.
+func EndsWith(field, suffix string) Condition {
+	return likeCondition(field, "%"+EscapeSOQLLike(suffix))
+}
+
+// likeCondition builds "field LIKE 'pattern'" from an already escaped pattern.
+func likeCondition(field, escapedPattern string) Condition {
+	if err := validateField("WHERE", field); err != nil {
+		return Condition{err: err}
+	}
+	return Condition{expr: field + " LIKE '" + escapedPattern + "'"}
+}
+
+// Includes builds "field INCLUDES ('a', 'b')" for a multi-select picklist:
+// records having any of the values (each an exact match).
+func Includes(field string, values ...string) Condition {
+	if err := validatePicklistValues(values); err != nil {
+		return Condition{err: err}
+	}
+	return picklistCondition(field, "INCLUDES", values)
+}
+
+// IncludesAll builds "field INCLUDES ('a;b')": records having all of the values.
+func IncludesAll(field string, values ...string) Condition {
+	if len(values) == 0 {
+		return picklistCondition(field, "INCLUDES", nil)
+	}
+	if err := validatePicklistValues(values); err != nil {
+		return Condition{err: err}
+	}
+	return picklistCondition(field, "INCLUDES", []string{JoinMultiPicklist(values)})
+}
+
+// Excludes builds "field EXCLUDES ('a', 'b')": records having none of the values.
+func Excludes(field string, values ...string) Condition {
+	if err := validatePicklistValues(values); err != nil {
+		return Condition{err: err}
+	}
+	return picklistCondition(field, "EXCLUDES", values)
+}
+
+// picklistCondition builds "field op ('v1', 'v2')" for multi-select picklists.
+func picklistCondition(field, op string, values []string) Condition {
+	if err := validateField("WHERE", field); err != nil {
+		return Condition{err: err}
+	}
+	if len(values) == 0 {
+		return Condition{err: &SOQLError{Clause: "WHERE", Message: op + " requires at least one value for " + field}}
+	}
+	literals := make([]string, len(values))
+	for i, v := range values {
+		literals[i] = StringLiteral(v).text
+	}
+	return Condition{expr: field + " " + op + " (" + strings.Join(literals, ", ") + ")"}
+}
+
+// validatePicklistValues rejects values that would be split by the ';' separator.
+func validatePicklistValues(values []string) error {
+	for _, v := range values {
+		if v == "" || strings.Contains(v, ";") {
+			return &SOQLError{Clause: "WHERE", Message: fmt.Sprintf("invalid picklist value %q", v)}
+		}
+	}
+	return nil
+}
+
+// And joins conditions with AND. Empty conditions are ignored.
+func And(conds ...Condition) Condition {
+	return join("AND", conds)
+}
+
+// Or joins conditions with OR. Empty conditions are ignored.
+func Or(conds ...Condition) Condition {
+	return join("OR", conds)
+}
+
+// Not negates a condition.
+func Not(cond Condition) Condition {
+	if cond.err != nil {
+		return cond
+	}
+	if cond.expr == "" {
+		return Condition{err: &SOQLError{Clause: "WHERE", Message: "NOT requires a condition"}}
+	}
+	return Condition{expr: "NOT (" + cond.expr + ")"}
+}
+
+// join combines conditions with a logical operator, parenthesizing each part.
+func join(op string, conds []Condition) Condition {
+	parts := make([]string, 0, len(conds))
+	for _, c := range conds {
+		if c.err != nil {
+			return c
+		}
+		if c.expr == "" {
+			continue
+		}
+		parts = append(parts, c.expr)
+	}
+	switch len(parts) {
+	case 0:
+		return Condition{}
+	case 1:
+		return Condition{expr: parts[0]}
+	}
+	return Condition{expr: "(" + strings.Join(parts, ") "+op+" (") + ")"}
+}
+
+// ============================================================================
+// QueryBuilder - SELECT Statement Construction
+// ============================================================================
+
+// MaxSOQLOffset is the largest OFFSET Salesforce accepts.
+const MaxSOQLOffset = 2000
+
+// QueryBuilder builds a SOQL SELECT statement.
+type QueryBuilder struct {
+	object  string
+	fields  []string
+	where   []Condition
+	orderBy []string
+	limit   int
+	offset  int
+	err     error
+}
+
+// NewQuery starts a query against objectName.
+func NewQuery(objectName string) *QueryBuilder {
+	q := &QueryBuilder{object: objectName, limit: -1, offset: -1}
+	if err := ValidateSObjectName(objectName); err != nil {
+		q.err = &SOQLError{Clause: "FROM", Message: err.Error()}
+	}
+	return q
+}
+
+// Select appends fields to the SELECT list.
+func (q *QueryBuilder) Select(fields ...string) *QueryBuilder {
+	for _, f := range fields {
+		if err := validateField("SELECT", f); err != nil && q.err == nil {
+			q.err = err
+		}
+	}
+	q.fields = append(q.fields, fields...)
+	return q
+}
+
+// Where adds conditions; multiple calls and arguments are combined with AND.
+func (q *QueryBuilder) Where(conds ...Condition) *QueryBuilder {
+	q.where = append(q.where, conds...)
+	return q
+}
+
+// OrderByAsc appends "field ASC" to the ORDER BY clause.
+func (q *QueryBuilder) OrderByAsc(field string) *QueryBuilder {
+	return q.order(field, "ASC")
+}
+
+// OrderByDesc appends "field DESC" to the ORDER BY clause.
+func (q *QueryBuilder) OrderByDesc(field string) *QueryBuilder {
+	return q.order(field, "DESC")
+}
+
+// order appends an ORDER BY term.
+func (q *QueryBuilder) order(field, direction string) *QueryBuilder {
+	if err := validateField("ORDER BY", field); err != nil && q.err == nil {
+		q.err = err
+	}
+	q.orderBy = append(q.orderBy, field+" "+direction)
+	return q
+}
+
+// Limit sets the LIMIT clause.
+func (q *QueryBuilder) Limit(n int) *QueryBuilder {
+	if n < 0 && q.err == nil {
+		q.err = &SOQLError{Clause: "LIMIT", Message: "must not be negative"}
+	}
+	q.limit = n
+	return q
+}
+
+// Offset sets the OFFSET clause.
+func (q *QueryBuilder) Offset(n int) *QueryBuilder {
+	if (n < 0 || n > MaxSOQLOffset) && q.err == nil {
+		q.err = &SOQLError{Clause: "OFFSET", Message: fmt.Sprintf("must be between 0 and %d", MaxSOQLOffset)}
+	}
+	q.offset = n
+	return q
+}
+
+// Build renders the query or returns the first construction error.
+func (q *QueryBuilder) Build() (string, error) {
+	if q.err != nil {
+		return "", q.err
+	}
+	if len(q.fields) == 0 {
+		return "", &SOQLError{Clause: "SELECT", Message: "no fields selected"}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	sb.WriteString(strings.Join(q.fields, ", "))
+	sb.WriteString(" FROM ")
+	sb.WriteString(q.object)
+	if err := q.writeClauses(&sb); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// writeClauses renders the WHERE, ORDER BY, LIMIT and OFFSET clauses, which
+// SELECT statements and SOSL RETURNING specs share.
+func (q *QueryBuilder) writeClauses(sb *strings.Builder) error {
+	where := And(q.where...)
+	if where.err != nil {
+		return where.err
+	}
+	if where.expr != "" {
+		sb.WriteString(" WHERE ")
+		sb.WriteString(where.expr)
+	}
+	if len(q.orderBy) > 0 {
+		sb.WriteString(" ORDER BY ")
+		sb.WriteString(strings.Join(q.orderBy, ", "))
+	}
+	if q.limit >= 0 {
+		sb.WriteString(" LIMIT ")
+		sb.WriteString(strconv.Itoa(q.limit))
+	}
+	if q.offset >= 0 {
+		sb.WriteString(" OFFSET ")
+		sb.WriteString(strconv.Itoa(q.offset))
+	}
+	return nil
+}
+
+// ============================================================================
+// Escaping
+// ============================================================================
+
+// soqlEscaper escapes SOQL string literal metacharacters. The backslash is
+// handled in the same pass as the quote, so escapes are never double-applied.
+var soqlEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`'`, `\'`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"\b", `\b`,
+	"\f", `\f`,
+)
+
+// EscapeSOQL escapes special characters in SOQL string literals.
+// This prevents SOQL injection attacks.
+func EscapeSOQL(s string) string {
+	return soqlEscaper.Replace(s)
+}
+
+// EscapeSOQLLike escapes a value for use inside a LIKE pattern: string literal
+// metacharacters plus the % and _ wildcards.
+func EscapeSOQLLike(s string) string {
+	s = EscapeSOQL(s)
+	s = strings.ReplaceAll(s, "%", `\%`)
+	s = strings.ReplaceAll(s, "_", `\_`)
+	return s
+}
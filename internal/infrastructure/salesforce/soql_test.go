@@ -0,0 +1,245 @@
+package salesforce
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// ============================================================================
+// Test Helpers - Minimal SOQL Literal Lexer
+// ============================================================================
+
+// soqlUnescapes maps SOQL escape sequences to the characters they denote.
+var soqlUnescapes = map[byte]string{
+	'n': "\n", 'r': "\r", 't': "\t", 'b': "\b", 'f': "\f",
+	'"': `"`, '\'': "'", '\\': `\`,
+	// Only valid inside LIKE patterns; kept escaped so wildcards stay literal
+	'%': `\%`, '_': `\_`,
+}
+
+// lexStringLiteral reads a quoted SOQL string literal from the start of s the
+// way Salesforce does, returning the decoded value and the remaining input.
+func lexStringLiteral(s string) (value, rest string, err error) {
+	if !strings.HasPrefix(s, "'") {
+		return "", s, errors.New("literal must start with a quote")
+	}
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\'':
+			return sb.String(), s[i+1:], nil
+		case '\\':
+			if i+1 >= len(s) {
+				return "", "", errors.New("dangling backslash")
+			}
+			decoded, ok := soqlUnescapes[s[i+1]]
+			if !ok {
+				return "", "", fmt.Errorf("invalid escape \\%c", s[i+1])
+			}
+			sb.WriteString(decoded)
+			i++
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return "", "", errors.New("unterminated literal")
+}
+
+// ============================================================================
+// SOQLFields Tests
+// ============================================================================
+
+func TestSOQLFields_NippouSF(t *testing.T) {
+	expected := []string{
+		"Id", "Date__c", "Content__c", "Latitude__c", "Longitude__c", "Address__c",
+		"VoiceEnabled__c", "VoiceModel__c", "Tags__c", "TagSet__c", "CreatedDate", "LastModifiedDate",
+	}
+	got := SOQLFields(&NippouSF{})
+	if strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Errorf("SOQLFields() = %v, want %v", got, expected)
+	}
+}
+
+// ============================================================================
+// QueryBuilder Tests
+// ============================================================================
+
+func TestQueryBuilder_Build(t *testing.T) {
+	date := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		query    *QueryBuilder
+		expected string
+	}{
+		{
+			name:     "select only",
+			query:    NewQuery("Account").Select("Id", "Name"),
+			expected: "SELECT Id, Name FROM Account",
+		},
+		{
+			name: "date range with order",
+			query: NewQuery("Nippou__c").Select("Id").
+				Where(Gte("Date__c", DateLiteral(date)), Lte("Date__c", DateLiteral(date.AddDate(0, 0, 6)))).
+				OrderByAsc("Date__c").OrderByDesc("CreatedDate"),
+			expected: "SELECT Id FROM Nippou__c WHERE (Date__c >= 2024-01-15) AND (Date__c <= 2024-01-21) " +
+				"ORDER BY Date__c ASC, CreatedDate DESC",
+		},
+		{
+			name:     "string equality is escaped",
+			query:    NewQuery("Account").Select("Id").Where(Eq("Name", StringLiteral(`O'Brien\`))),
+			expected: `SELECT Id FROM Account WHERE Name = 'O\'Brien\\'`,
+		},
+		{
+			name:     "IN list",
+			query:    NewQuery("Account").Select("Id").Where(In("Type", StringLiteral("a"), StringLiteral("b"))),
+			expected: "SELECT Id FROM Account WHERE Type IN ('a', 'b')",
+		},
+		{
+			name:     "LIKE escapes wildcards",
+			query:    NewQuery("Nippou__c").Select("Id").Where(Contains("Tags__c", "50%_off")),
+			expected: `SELECT Id FROM Nippou__c WHERE Tags__c LIKE '%50\%\_off%'`,
+		},
+		{
+			name: "nested logic",
+			query: NewQuery("Account").Select("Id").Where(
+				Or(Eq("Active__c", BoolLiteral(true)), Not(Eq("Score__c", FloatLiteral(1.5))))),
+			expected: "SELECT Id FROM Account WHERE (Active__c = true) OR (NOT (Score__c = 1.5))",
+		},
+		{
+			name:     "limit and offset",
+			query:    NewQuery("Account").Select("Owner.Name").Limit(10).Offset(20),
+			expected: "SELECT Owner.Name FROM Account LIMIT 10 OFFSET 20",
+		},
+		{
+			name:     "datetime and null",
+			query:    NewQuery("Account").Select("Id").Where(Gt("CreatedDate", DateTimeLiteral(date)), Ne("Name", NullLiteral())),
+			expected: "SELECT Id FROM Account WHERE (CreatedDate > 2024-01-15T00:00:00Z) AND (Name != null)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query.Build()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Build() =\n  %s\nwant\n  %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestQueryBuilder_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *QueryBuilder
+	}{
+		{"invalid object", NewQuery("Account; DELETE").Select("Id")},
+		{"no fields", NewQuery("Account")},
+		{"invalid select field", NewQuery("Account").Select("Id, (SELECT Id FROM Contacts)")},
+		{"invalid where field", NewQuery("Account").Select("Id").Where(Eq("Name OR 1", IntLiteral(1)))},
+		{"invalid order field", NewQuery("Account").Select("Id").OrderByAsc("Name; x")},
+		{"empty IN", NewQuery("Account").Select("Id").Where(In("Id"))},
+		{"separator in INCLUDES value", NewQuery("Nippou__c").Select("Id").Where(Includes("TagSet__c", "sales;visit"))},
+		{"separator in EXCLUDES value", NewQuery("Nippou__c").Select("Id").Where(Excludes("TagSet__c", "a", "b;c"))},
+		{"empty INCLUDES value", NewQuery("Nippou__c").Select("Id").Where(Includes("TagSet__c", ""))},
+		{"zero literal", NewQuery("Account").Select("Id").Where(Eq("Id", Literal{}))},
+		{"negative limit", NewQuery("Account").Select("Id").Limit(-1)},
+		{"offset too large", NewQuery("Account").Select("Id").Offset(MaxSOQLOffset + 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.query.Build()
+			var soqlErr *SOQLError
+			if !errors.As(err, &soqlErr) {
+				t.Errorf("expected SOQLError, got %v", err)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Fuzz Tests - Injection Resistance
+// ============================================================================
+
+// injectionSeeds are classic SOQL injection payloads.
+var injectionSeeds = []string{
+	"",
+	"simple",
+	"' OR Name != '",
+	`\' OR Id != null OR Name = \'`,
+	`\\' OR 1=1 --`,
+	"'; DELETE FROM Account",
+	"line\nbreak\r\ttab",
+	"%_wild%_",
+	`trailing\`,
+	"日報'商談\\",
+}
+
+func FuzzStringLiteral(f *testing.F) {
+	for _, seed := range injectionSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		if !utf8.ValidString(input) {
+			t.Skip()
+		}
+		query, err := NewQuery("Account").Select("Id").Where(Eq("Name", StringLiteral(input))).Limit(1).Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		prefix := "SELECT Id FROM Account WHERE Name = "
+		if !strings.HasPrefix(query, prefix) {
+			t.Fatalf("unexpected query shape: %s", query)
+		}
+		value, rest, err := lexStringLiteral(strings.TrimPrefix(query, prefix))
+		if err != nil {
+			t.Fatalf("literal did not lex for %q: %v (query %s)", input, err, query)
+		}
+		if value != input {
+			t.Fatalf("literal decoded to %q, want %q", value, input)
+		}
+		if rest != " LIMIT 1" {
+			t.Fatalf("input escaped the literal: remaining %q", rest)
+		}
+	})
+}
+
+func FuzzContains(f *testing.F) {
+	for _, seed := range injectionSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		if !utf8.ValidString(input) {
+			t.Skip()
+		}
+		query, err := NewQuery("Nippou__c").Select("Id").Where(Contains("Tags__c", input)).Limit(1).Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		prefix := "SELECT Id FROM Nippou__c WHERE Tags__c LIKE "
+		pattern, rest, err := lexStringLiteral(strings.TrimPrefix(query, prefix))
+		if err != nil {
+			t.Fatalf("pattern did not lex for %q: %v (query %s)", input, err, query)
+		}
+		if rest != " LIMIT 1" {
+			t.Fatalf("input escaped the literal: remaining %q", rest)
+		}
+
+		// The only unescaped wildcards are the surrounding ones
+		inner := strings.TrimSuffix(strings.TrimPrefix(pattern, "%"), "%")
+		unescaped := strings.NewReplacer(`\%`, "", `\_`, "").Replace(inner)
+		if strings.ContainsAny(unescaped, "%_") {
+			t.Fatalf("unescaped wildcard in pattern %q for input %q", pattern, input)
+		}
+		if decoded := strings.NewReplacer(`\%`, "%", `\_`, "_").Replace(inner); decoded != input {
+			t.Fatalf("pattern decoded to %q, want %q", decoded, input)
+		}
+	})
+}
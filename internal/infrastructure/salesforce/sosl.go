@@ -0,0 +1,181 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// Search Scopes
+// ============================================================================
+
+// SearchScope is the IN clause of a SOSL search: which fields are searched.
+type SearchScope string
+
+const (
+	// SearchAllFields searches every searchable field, including long text areas.
+	SearchAllFields SearchScope = "ALL FIELDS"
+	// SearchNameFields searches only name fields.
+	SearchNameFields SearchScope = "NAME FIELDS"
+	// SearchEmailFields searches only email fields.
+	SearchEmailFields SearchScope = "EMAIL FIELDS"
+	// SearchPhoneFields searches only phone fields.
+	SearchPhoneFields SearchScope = "PHONE FIELDS"
+)
+
+// MaxSOSLLimit is the largest LIMIT Salesforce accepts for a SOSL search.
+const MaxSOSLLimit = 2000
+
+// ============================================================================
+// SearchBuilder - FIND Statement Construction
+// ============================================================================
+
+// SearchBuilder builds a SOSL FIND statement. Each RETURNING spec reuses a
+// QueryBuilder for its fields, WHERE, ORDER BY, LIMIT and OFFSET clauses.
+type SearchBuilder struct {
+	term      string
+	scope     SearchScope
+	returning []*QueryBuilder
+	limit     int
+	err       error
+}
+
+// NewSearch starts a search for term. The term is matched literally: SOSL
+// operators and wildcards in it are escaped, the AND, OR and NOT keywords are
+// quoted so they are searched for as words, and whitespace is collapsed.
+// Multiple words must all appear (SOSL's implicit AND).
+func NewSearch(term string) *SearchBuilder {
+	s := &SearchBuilder{term: strings.Join(strings.Fields(term), " "), scope: SearchAllFields, limit: -1}
+	if s.term == "" {
+		s.err = &SOQLError{Clause: "FIND", Message: "search term cannot be empty"}
+	}
+	return s
+}
+
+// In sets the search scope (default SearchAllFields).
+func (s *SearchBuilder) In(scope SearchScope) *SearchBuilder {
+	s.scope = scope
+	return s
+}
+
+// Returning adds an object to the RETURNING clause. The query's object and
+// fields become "Object(fields ...)"; its WHERE, ORDER BY, LIMIT and OFFSET
+// clauses filter that object's matches.
+func (s *SearchBuilder) Returning(q *QueryBuilder) *SearchBuilder {
+	s.returning = append(s.returning, q)
+	return s
+}
+
+// Limit sets the overall LIMIT across all returned objects.
+func (s *SearchBuilder) Limit(n int) *SearchBuilder {
+	if (n < 0 || n > MaxSOSLLimit) && s.err == nil {
+		s.err = &SOQLError{Clause: "LIMIT", Message: fmt.Sprintf("must be between 0 and %d", MaxSOSLLimit)}
+	}
+	s.limit = n
+	return s
+}
+
+// Build renders the search or returns the first construction error.
+func (s *SearchBuilder) Build() (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	switch s.scope {
+	case SearchAllFields, SearchNameFields, SearchEmailFields, SearchPhoneFields:
+	default:
+		return "", &SOQLError{Clause: "IN", Message: fmt.Sprintf("invalid search scope %q", s.scope)}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("FIND {")
+	sb.WriteString(soslTerm(s.term))
+	sb.WriteString("} IN ")
+	sb.WriteString(string(s.scope))
+
+	if len(s.returning) > 0 {
+		sb.WriteString(" RETURNING ")
+		for i, q := range s.returning {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if err := q.writeReturning(&sb); err != nil {
+				return "", err
+			}
+		}
+	}
+	if s.limit >= 0 {
+		sb.WriteString(" LIMIT ")
+		sb.WriteString(strconv.Itoa(s.limit))
+	}
+	return sb.String(), nil
+}
+
+// writeReturning renders the query as a RETURNING spec: "Object(fields ...)".
+func (q *QueryBuilder) writeReturning(sb *strings.Builder) error {
+	if q.err != nil {
+		return q.err
+	}
+	sb.WriteString(q.object)
+	if len(q.fields) == 0 {
+		return nil
+	}
+	sb.WriteString("(")
+	sb.WriteString(strings.Join(q.fields, ", "))
+	if err := q.writeClauses(sb); err != nil {
+		return err
+	}
+	sb.WriteString(")")
+	return nil
+}
+
+// ============================================================================
+// Escaping
+// ============================================================================
+
+// soslReserved lists the characters that have special meaning in a SOSL
+// search term and must be backslash-escaped to be matched literally.
+const soslReserved = `?&|!{}[]()^~*:\"'+-`
+
+// EscapeSOSL escapes SOSL reserved characters in a search term so that
+// operators, wildcards and braces in user input are matched literally. The
+// AND, OR and NOT keywords are words, not characters, and are left as they
+// are; NewSearch quotes them.
+func EscapeSOSL(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for _, r := range s {
+		if strings.ContainsRune(soslReserved, r) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// soslTerm escapes each word of term and quotes the boolean keywords, so that
+// "価格 OR 改定" looks for all three words instead of either one.
+func soslTerm(term string) string {
+	words := strings.Fields(term)
+	for i, w := range words {
+		switch strings.ToUpper(w) {
+		case "AND", "OR", "NOT":
+			words[i] = `"` + w + `"`
+		default:
+			words[i] = EscapeSOSL(w)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// ============================================================================
+// Client Search Method
+// ============================================================================
+
+// Search executes a SOSL search and returns the result. Like Query, the
+// search string must already be URL-encoded.
+func (c *Client) Search(ctx context.Context, sosl string, result interface{}) error {
+	path := fmt.Sprintf("/search?q=%s", sosl)
+	return c.Get(ctx, path, result)
+}
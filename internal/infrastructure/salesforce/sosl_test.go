@@ -0,0 +1,200 @@
+package salesforce
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// EscapeSOSL Tests
+// ============================================================================
+
+func TestEscapeSOSL(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"価格改定", "価格改定"},
+		{"price-up", `price\-up`},
+		{"a OR b*", `a OR b\*`},
+		{"}} RETURNING User(Id) FIND {x", `\}\} RETURNING User\(Id\) FIND \{x`},
+		{`back\slash "quoted"`, `back\\slash \"quoted\"`},
+		{"?&|!^~:'+[]", `\?\&\|\!\^\~\:\'\+\[\]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := EscapeSOSL(tt.input); got != tt.expected {
+				t.Errorf("EscapeSOSL(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// SearchBuilder Tests
+// ============================================================================
+
+func TestSearchBuilder_Build(t *testing.T) {
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		search   *SearchBuilder
+		expected string
+	}{
+		{
+			name:     "term only",
+			search:   NewSearch("  価格改定  "),
+			expected: "FIND {価格改定} IN ALL FIELDS",
+		},
+		{
+			name: "returning with filters",
+			search: NewSearch("price up").Returning(NewQuery("Nippou__c").Select("Id", "Date__c").
+				Where(Gte("Date__c", DateLiteral(date))).OrderByDesc("Date__c").Limit(50)),
+			expected: "FIND {price up} IN ALL FIELDS RETURNING Nippou__c(Id, Date__c WHERE Date__c >= 2024-01-01 " +
+				"ORDER BY Date__c DESC LIMIT 50)",
+		},
+		{
+			name:     "scope and overall limit",
+			search:   NewSearch("tanaka").In(SearchNameFields).Returning(NewQuery("Account").Select("Id")).Limit(10),
+			expected: "FIND {tanaka} IN NAME FIELDS RETURNING Account(Id) LIMIT 10",
+		},
+		{
+			name:     "injection is escaped",
+			search:   NewSearch("x} RETURNING User(Id) FIND {y").Returning(NewQuery("Nippou__c").Select("Id")),
+			expected: `FIND {x\} RETURNING User\(Id\) FIND \{y} IN ALL FIELDS RETURNING Nippou__c(Id)`,
+		},
+		{
+			name:     "boolean keywords are searched as words",
+			search:   NewSearch("価格 OR 改定 and not Nota"),
+			expected: `FIND {価格 "OR" 改定 "and" "not" Nota} IN ALL FIELDS`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.search.Build()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Build() =\n  %s\nwant\n  %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSearchBuilder_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		search *SearchBuilder
+	}{
+		{"empty term", NewSearch(" \t ")},
+		{"invalid scope", NewSearch("x").In(SearchScope("ALL FIELDS) RETURNING User"))},
+		{"invalid returning object", NewSearch("x").Returning(NewQuery("User(Id)").Select("Id"))},
+		{"invalid returning condition", NewSearch("x").Returning(NewQuery("Nippou__c").Select("Id").Where(Eq("a b", IntLiteral(1))))},
+		{"limit too large", NewSearch("x").Limit(MaxSOSLLimit + 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.search.Build()
+			var soqlErr *SOQLError
+			if !errors.As(err, &soqlErr) {
+				t.Errorf("expected SOQLError, got %v", err)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// NippouRepository.Search Tests
+// ============================================================================
+
+func TestNippouRepository_Search(t *testing.T) {
+	var gotPath, gotSOSL string
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			gotPath = req.URL.Path
+			gotSOSL, _ = url.QueryUnescape(strings.TrimPrefix(req.URL.RawQuery, "q="))
+			return newMockResponse(200, SearchResult{SearchRecords: []NippouSF{
+				{ID: "550e8400-e29b-41d4-a716-446655440000", Date: "2024-02-01", Content: "価格改定の説明", TagSet: "sales"},
+				{ID: "invalid", Date: "2024-02-02", Content: "skipped"},
+			}}), nil
+		},
+	}
+	repo := NewNippouRepository(newTestClient(mockHTTP))
+
+	results, err := repo.Search("価格改定", SearchFilters{
+		StartDate: nippou.CivilDateOf(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:   nippou.CivilDateOf(time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)),
+		Tags:      []string{"Sales", "visit"},
+		TagMatch:  TagMatchAll,
+		Limit:     100,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasSuffix(gotPath, "/search") {
+		t.Errorf("expected /search endpoint, got %s", gotPath)
+	}
+	expected := "FIND {価格改定} IN ALL FIELDS RETURNING Nippou__c(" + strings.Join(nippouFields, ", ") +
+		" WHERE (Date__c >= 2024-01-01) AND (Date__c <= 2024-03-31) AND (TagSet__c INCLUDES ('sales;visit')) LIMIT 100)"
+	if gotSOSL != expected {
+		t.Errorf("SOSL =\n  %s\nwant\n  %s", gotSOSL, expected)
+	}
+	if len(results) != 1 || results[0].Content() != "価格改定の説明" {
+		t.Errorf("unexpected results: %v", results)
+	}
+}
+
+func TestNippouRepository_Search_NoFilters(t *testing.T) {
+	var gotSOSL string
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			gotSOSL, _ = url.QueryUnescape(strings.TrimPrefix(req.URL.RawQuery, "q="))
+			return newMockResponse(200, SearchResult{}), nil
+		},
+	}
+	repo := NewNippouRepository(newTestClient(mockHTTP))
+
+	if _, err := repo.Search("visit", SearchFilters{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(gotSOSL, "WHERE") || strings.Contains(gotSOSL, "LIMIT") {
+		t.Errorf("expected no filters: %s", gotSOSL)
+	}
+}
+
+func TestNippouRepository_Search_Errors(t *testing.T) {
+	mockHTTP := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			t.Errorf("no request expected")
+			return newMockResponse(500, nil), nil
+		},
+	}
+	repo := NewNippouRepository(newTestClient(mockHTTP))
+
+	for name, call := range map[string]func() error{
+		"empty query": func() error { _, err := repo.Search("  ", SearchFilters{}); return err },
+		"invalid tag": func() error { _, err := repo.Search("x", SearchFilters{Tags: []string{"a;b"}}); return err },
+		"limit too large": func() error {
+			_, err := repo.Search("x", SearchFilters{Limit: MaxSOSLLimit + 1})
+			var soqlErr *SOQLError
+			if !errors.As(err, &soqlErr) || soqlErr.Clause != "LIMIT" {
+				t.Errorf("limit too large: expected LIMIT SOQLError, got %v", err)
+			}
+			return err
+		},
+		"negative limit": func() error { _, err := repo.Search("x", SearchFilters{Limit: -1}); return err },
+	} {
+		var repoErr *RepositoryError
+		if err := call(); !errors.As(err, &repoErr) {
+			t.Errorf("%s: expected RepositoryError, got %v", name, err)
+		}
+	}
+}
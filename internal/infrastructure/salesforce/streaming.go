@@ -0,0 +1,535 @@
+package salesforce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Change Data Capture - Nippou__ChangeEvent Mapping
+// ============================================================================
+
+// NippouChangeEventChannel is the Change Data Capture channel for Nippou__c.
+const NippouChangeEventChannel = "/data/Nippou__ChangeEvent"
+
+// Change Data Capture change types, as found in ChangeEventHeader.ChangeType.
+const (
+	CDCCreate   = "CREATE"
+	CDCUpdate   = "UPDATE"
+	CDCDelete   = "DELETE"
+	CDCUndelete = "UNDELETE"
+)
+
+// Replay positions used when no replay ID has been stored for a channel.
+const (
+	ReplayNewEvents int64 = -1 // Only events published after subscribing
+	ReplayAllEvents int64 = -2 // Every event still retained by Salesforce
+)
+
+// ChangeEventHeader describes the change carried by a change event.
+type ChangeEventHeader struct {
+	EntityName      string   `json:"entityName"`
+	RecordIDs       []string `json:"recordIds"`
+	ChangeType      string   `json:"changeType"`
+	ChangeOrigin    string   `json:"changeOrigin"`
+	TransactionKey  string   `json:"transactionKey"`
+	SequenceNumber  int      `json:"sequenceNumber"`
+	CommitTimestamp int64    `json:"commitTimestamp"` // Milliseconds since the epoch
+	CommitUser      string   `json:"commitUser"`
+	ChangedFields   []string `json:"changedFields"`
+}
+
+// CommitTime returns CommitTimestamp as a time.
+func (h ChangeEventHeader) CommitTime() time.Time {
+	return time.UnixMilli(h.CommitTimestamp).UTC()
+}
+
+// NippouChangeEvent is a decoded Nippou__ChangeEvent. Fields holds the values
+// sent with the event: all fields for CREATE, only the changed ones (listed
+// in Header.ChangedFields) for UPDATE, and none for DELETE.
+type NippouChangeEvent struct {
+	ReplayID int64
+	Header   ChangeEventHeader
+	Fields   NippouSF
+}
+
+// decodeNippouChangeEvent decodes the data of a change event message.
+func decodeNippouChangeEvent(data json.RawMessage) (*NippouChangeEvent, error) {
+	var envelope struct {
+		Payload json.RawMessage `json:"payload"`
+		Event   struct {
+			ReplayID int64 `json:"replayId"`
+		} `json:"event"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse change event: %w", err)
+	}
+	var header struct {
+		Header ChangeEventHeader `json:"ChangeEventHeader"`
+	}
+	if err := json.Unmarshal(envelope.Payload, &header); err != nil {
+		return nil, fmt.Errorf("failed to parse change event header: %w", err)
+	}
+	event := &NippouChangeEvent{ReplayID: envelope.Event.ReplayID, Header: header.Header}
+	if err := json.Unmarshal(envelope.Payload, &event.Fields); err != nil {
+		return nil, fmt.Errorf("failed to parse change event fields: %w", err)
+	}
+	if len(header.Header.RecordIDs) > 0 {
+		event.Fields.ID = header.Header.RecordIDs[0]
+	}
+	return event, nil
+}
+
+// ============================================================================
+// ReplayStore - Durable Replay IDs
+// ============================================================================
+
+// ReplayStore remembers the last processed replay ID per channel so a
+// restarted subscriber resumes where it stopped.
+type ReplayStore interface {
+	LoadReplayID(channel string) (id int64, ok bool, err error)
+	SaveReplayID(channel string, id int64) error
+}
+
+// FileReplayStore keeps replay IDs in a JSON file keyed by channel.
+type FileReplayStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileReplayStore creates a store backed by the file at path.
+func NewFileReplayStore(path string) *FileReplayStore {
+	return &FileReplayStore{path: path}
+}
+
+// LoadReplayID returns the stored replay ID for channel.
+func (s *FileReplayStore) LoadReplayID(channel string) (int64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids, err := s.load()
+	if err != nil {
+		return 0, false, err
+	}
+	id, ok := ids[channel]
+	return id, ok, nil
+}
+
+// SaveReplayID stores id for channel.
+func (s *FileReplayStore) SaveReplayID(channel string, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids, err := s.load()
+	if err != nil {
+		return err
+	}
+	ids[channel] = id
+	data, err := json.MarshalIndent(ids, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode replay IDs: %w", err)
+	}
+	if err := writeFileAtomic(s.path, data); err != nil {
+		return fmt.Errorf("write replay IDs: %w", err)
+	}
+	return nil
+}
+
+// load reads the file; a missing file means no IDs.
+func (s *FileReplayStore) load() (map[string]int64, error) {
+	ids := make(map[string]int64)
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ids, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read replay IDs: %w", err)
+	}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return nil, fmt.Errorf("decode replay IDs %s: %w", s.path, err)
+	}
+	return ids, nil
+}
+
+// ============================================================================
+// Bayeux Protocol Messages
+// ============================================================================
+
+// Bayeux meta channels.
+const (
+	metaHandshake  = "/meta/handshake"
+	metaSubscribe  = "/meta/subscribe"
+	metaConnect    = "/meta/connect"
+	metaDisconnect = "/meta/disconnect"
+)
+
+// Bayeux advice reconnect values.
+const (
+	reconnectRetry     = "retry"
+	reconnectHandshake = "handshake"
+	reconnectNone      = "none"
+)
+
+// bayeuxMessage is a Bayeux message in either direction.
+type bayeuxMessage struct {
+	Channel                  string                 `json:"channel"`
+	ClientID                 string                 `json:"clientId,omitempty"`
+	Version                  string                 `json:"version,omitempty"`
+	SupportedConnectionTypes []string               `json:"supportedConnectionTypes,omitempty"`
+	ConnectionType           string                 `json:"connectionType,omitempty"`
+	Subscription             string                 `json:"subscription,omitempty"`
+	Successful               bool                   `json:"successful,omitempty"`
+	Error                    string                 `json:"error,omitempty"`
+	Advice                   *bayeuxAdvice          `json:"advice,omitempty"`
+	Ext                      map[string]interface{} `json:"ext,omitempty"`
+	Data                     json.RawMessage        `json:"data,omitempty"`
+}
+
+// bayeuxAdvice is server advice on how to reconnect.
+type bayeuxAdvice struct {
+	Reconnect string `json:"reconnect,omitempty"`
+	Interval  int    `json:"interval,omitempty"` // Milliseconds to wait before the next connect
+	Timeout   int    `json:"timeout,omitempty"`
+}
+
+// StreamingError reports a failed Bayeux exchange.
+type StreamingError struct {
+	Channel string
+	Message string
+	Cause   error
+
+	// Transient is set when the request failed in transit or Salesforce was
+	// briefly unavailable (429 or 5xx), so a later attempt may succeed.
+	Transient bool
+}
+
+func (e *StreamingError) Error() string {
+	return fmt.Sprintf("streaming %s failed: %s", e.Channel, e.Message)
+}
+
+func (e *StreamingError) Unwrap() error {
+	return e.Cause
+}
+
+// newStreamingError wraps a failed exchange on channel, marking it transient
+// when retrying may help.
+func newStreamingError(channel string, err error) *StreamingError {
+	return &StreamingError{Channel: channel, Message: err.Error(), Cause: err, Transient: isTransientSendError(err)}
+}
+
+// transportError marks a request that failed in transit.
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string { return e.err.Error() }
+
+func (e *transportError) Unwrap() error { return e.err }
+
+// isTransientSendError reports whether a failed send is worth retrying:
+// network failures and retryable API statuses, but not authentication or
+// permission rejections.
+func isTransientSendError(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.IsRetryable()
+	}
+	var transport *transportError
+	return errors.As(err, &transport)
+}
+
+// ============================================================================
+// StreamingClient - CometD Long-Polling Subscriber
+// ============================================================================
+
+// StreamingConfig holds configuration for the streaming subscriber.
+type StreamingConfig struct {
+	Channel    string        // Channel to subscribe to
+	ReplayFrom int64         // Replay position when no replay ID is stored
+	RetryDelay time.Duration // Wait after a failed connect before handshaking again
+}
+
+// DefaultStreamingConfig returns configuration for Nippou__c change events.
+func DefaultStreamingConfig() *StreamingConfig {
+	return &StreamingConfig{
+		Channel:    NippouChangeEventChannel,
+		ReplayFrom: ReplayNewEvents,
+		RetryDelay: 5 * time.Second,
+	}
+}
+
+// ChangeHandler processes one change event. Returning an error stops the
+// subscriber before the event's replay ID is stored, so it is delivered again
+// after a restart.
+type ChangeHandler func(ctx context.Context, event *NippouChangeEvent) error
+
+// StreamingClient subscribes to Change Data Capture events over CometD
+// (Bayeux long-polling) and records replay IDs durably.
+type StreamingClient struct {
+	client     *Client
+	httpClient HTTPDoer
+	replays    ReplayStore
+	config     *StreamingConfig
+	clientID   string
+	cookies    map[string]*http.Cookie
+}
+
+// NewStreamingClient creates a subscriber that authenticates through client
+// and stores replay IDs in replays. Long polls outlive the REST timeout, so
+// requests use a separate HTTP client without one; cancel the context to stop.
+func NewStreamingClient(client *Client, replays ReplayStore, config *StreamingConfig) *StreamingClient {
+	if config == nil {
+		config = DefaultStreamingConfig()
+	}
+	return &StreamingClient{
+		client:     client,
+		httpClient: &http.Client{},
+		replays:    replays,
+		config:     config,
+		cookies:    make(map[string]*http.Cookie),
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for Bayeux requests.
+func (s *StreamingClient) WithHTTPClient(httpClient HTTPDoer) *StreamingClient {
+	s.httpClient = httpClient
+	return s
+}
+
+// endpoint returns the CometD URL, e.g. https://host/cometd/59.0.
+func (s *StreamingClient) endpoint() string {
+	return fmt.Sprintf("%s/cometd/%s", s.client.config.BaseURL, strings.TrimPrefix(s.client.config.APIVersion, "v"))
+}
+
+// Run handshakes, subscribes and long-polls until ctx is done, the server
+// advises to stop or rejects the session, or handler fails. Dropped
+// connections, expired sessions and handshakes or subscribes that fail in
+// transit are retried after RetryDelay.
+func (s *StreamingClient) Run(ctx context.Context, handler ChangeHandler) error {
+	defer s.disconnect()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if s.clientID == "" {
+			if err := s.openSession(ctx); err != nil {
+				var streamErr *StreamingError
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if !errors.As(err, &streamErr) || !streamErr.Transient {
+					return err
+				}
+				// Salesforce is briefly unavailable: try a new session later
+				s.clientID = ""
+				if err := sleepContext(ctx, s.config.RetryDelay); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		messages, err := s.send(ctx, bayeuxMessage{Channel: metaConnect, ClientID: s.clientID, ConnectionType: "long-polling"})
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// Connection dropped or session expired: start a new session
+			s.clientID = ""
+			if err := sleepContext(ctx, s.config.RetryDelay); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var wait time.Duration
+		for _, msg := range messages {
+			switch msg.Channel {
+			case s.config.Channel:
+				if err := s.deliver(ctx, msg, handler); err != nil {
+					return err
+				}
+			case metaConnect:
+				if msg.Advice != nil {
+					wait = time.Duration(msg.Advice.Interval) * time.Millisecond
+				}
+				if msg.Successful {
+					continue
+				}
+				if msg.Advice != nil && msg.Advice.Reconnect == reconnectNone {
+					return &StreamingError{Channel: metaConnect, Message: msg.Error}
+				}
+				// Retry is advised after a handshake unless the server says otherwise
+				if msg.Advice == nil || msg.Advice.Reconnect != reconnectRetry {
+					s.clientID = ""
+				}
+			}
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// openSession handshakes and subscribes.
+func (s *StreamingClient) openSession(ctx context.Context) error {
+	if err := s.handshake(ctx); err != nil {
+		return err
+	}
+	return s.subscribe(ctx)
+}
+
+// handshake opens a Bayeux session.
+func (s *StreamingClient) handshake(ctx context.Context) error {
+	s.cookies = make(map[string]*http.Cookie)
+	replies, err := s.send(ctx, bayeuxMessage{
+		Channel:                  metaHandshake,
+		Version:                  "1.0",
+		SupportedConnectionTypes: []string{"long-polling"},
+	})
+	if err != nil {
+		return newStreamingError(metaHandshake, err)
+	}
+	reply, err := metaReply(replies, metaHandshake)
+	if err != nil {
+		return err
+	}
+	s.clientID = reply.ClientID
+	return nil
+}
+
+// subscribe subscribes to the configured channel from the stored replay ID.
+func (s *StreamingClient) subscribe(ctx context.Context) error {
+	replayID := s.config.ReplayFrom
+	if s.replays != nil {
+		stored, ok, err := s.replays.LoadReplayID(s.config.Channel)
+		if err != nil {
+			return &StreamingError{Channel: metaSubscribe, Message: err.Error(), Cause: err}
+		}
+		if ok {
+			replayID = stored
+		}
+	}
+	replies, err := s.send(ctx, bayeuxMessage{
+		Channel:      metaSubscribe,
+		ClientID:     s.clientID,
+		Subscription: s.config.Channel,
+		Ext:          map[string]interface{}{"replay": map[string]int64{s.config.Channel: replayID}},
+	})
+	if err != nil {
+		return newStreamingError(metaSubscribe, err)
+	}
+	_, err = metaReply(replies, metaSubscribe)
+	return err
+}
+
+// deliver decodes a change event, hands it to handler and stores its replay ID.
+func (s *StreamingClient) deliver(ctx context.Context, msg bayeuxMessage, handler ChangeHandler) error {
+	event, err := decodeNippouChangeEvent(msg.Data)
+	if err != nil {
+		return &StreamingError{Channel: msg.Channel, Message: err.Error(), Cause: err}
+	}
+	if err := handler(ctx, event); err != nil {
+		return err
+	}
+	if s.replays == nil {
+		return nil
+	}
+	if err := s.replays.SaveReplayID(s.config.Channel, event.ReplayID); err != nil {
+		return &StreamingError{Channel: msg.Channel, Message: err.Error(), Cause: err}
+	}
+	return nil
+}
+
+// disconnect ends the session on a best-effort basis.
+func (s *StreamingClient) disconnect() {
+	if s.clientID == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, _ = s.send(ctx, bayeuxMessage{Channel: metaDisconnect, ClientID: s.clientID})
+	s.clientID = ""
+}
+
+// send posts one Bayeux message and returns the messages in the response.
+// Session cookies set by the server are sent back on later requests.
+func (s *StreamingClient) send(ctx context.Context, msg bayeuxMessage) ([]bayeuxMessage, error) {
+	token, err := s.client.tokenProvider.GetToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auth token: %w", err)
+	}
+	body, err := json.Marshal([]bayeuxMessage{msg})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	for _, c := range s.cookies {
+		req.AddCookie(c)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, &transportError{err: fmt.Errorf("request failed: %w", err)}
+	}
+	defer resp.Body.Close()
+	for _, c := range resp.Cookies() {
+		s.cookies[c.Name] = c
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &transportError{err: fmt.Errorf("failed to read response body: %w", err)}
+	}
+	if resp.StatusCode >= 400 {
+		return nil, s.client.parseAPIError(resp.StatusCode, respBody)
+	}
+	var messages []bayeuxMessage
+	if err := json.Unmarshal(respBody, &messages); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return messages, nil
+}
+
+// metaReply returns the successful reply on channel.
+func metaReply(messages []bayeuxMessage, channel string) (*bayeuxMessage, error) {
+	for i := range messages {
+		if messages[i].Channel != channel {
+			continue
+		}
+		if !messages[i].Successful {
+			return nil, &StreamingError{Channel: channel, Message: messages[i].Error}
+		}
+		return &messages[i], nil
+	}
+	return nil, &StreamingError{Channel: channel, Message: "no reply"}
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,287 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Stand-in Bayeux Server
+// ============================================================================
+
+// fakeBayeux is a minimal CometD server speaking the long-polling transport.
+type fakeBayeux struct {
+	mu            sync.Mutex
+	sessions      int
+	expire        bool              // Fail the next connect with an unknown client
+	pending       []json.RawMessage // Events returned by the next connect
+	replays       []int64           // Replay IDs requested by subscribes
+	cookieSeen    bool              // A connect carried the session cookie
+	unauthorized  bool              // A request lacked the bearer token
+	handshakes    int               // Handshake requests received
+	failHandshake int               // Handshake answered with 503 Service Unavailable
+}
+
+func newFakeBayeux(t *testing.T) (*fakeBayeux, *httptest.Server) {
+	f := &fakeBayeux{}
+	server := httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(server.Close)
+	return f, server
+}
+
+// publish queues a change event with the given replay ID and payload.
+func (f *fakeBayeux) publish(replayID int64, payload string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pending = append(f.pending, json.RawMessage(fmt.Sprintf(
+		`{"schema":"abc","payload":%s,"event":{"replayId":%d}}`, payload, replayID)))
+}
+
+func (f *fakeBayeux) serve(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/cometd/59.0" || r.Method != http.MethodPost {
+		http.NotFound(w, r)
+		return
+	}
+	var messages []bayeuxMessage
+	if err := json.NewDecoder(r.Body).Decode(&messages); err != nil || len(messages) != 1 {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	msg := messages[0]
+	clientID := func() string { return fmt.Sprintf("client-%d", f.sessions) }
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r.Header.Get("Authorization") != "Bearer test-token" {
+		f.unauthorized = true
+	}
+
+	var replies []interface{}
+	switch msg.Channel {
+	case metaHandshake:
+		f.handshakes++
+		if f.handshakes == f.failHandshake {
+			http.Error(w, `[{"errorCode":"SERVER_UNAVAILABLE","message":"maintenance"}]`, http.StatusServiceUnavailable)
+			return
+		}
+		f.sessions++
+		http.SetCookie(w, &http.Cookie{Name: "BAYEUX_BROWSER", Value: clientID()})
+		replies = append(replies, bayeuxMessage{Channel: metaHandshake, ClientID: clientID(), Version: "1.0", Successful: true})
+	case metaSubscribe:
+		ext, _ := msg.Ext["replay"].(map[string]interface{})
+		if id, ok := ext[NippouChangeEventChannel].(float64); ok {
+			f.replays = append(f.replays, int64(id))
+		}
+		replies = append(replies, bayeuxMessage{Channel: metaSubscribe, ClientID: msg.ClientID, Subscription: msg.Subscription, Successful: true})
+	case metaConnect:
+		if c, err := r.Cookie("BAYEUX_BROWSER"); err == nil && c.Value == msg.ClientID {
+			f.cookieSeen = true
+		}
+		if f.expire || msg.ClientID != clientID() {
+			f.expire = false
+			replies = append(replies, bayeuxMessage{
+				Channel: metaConnect, Error: "403::Unknown client",
+				Advice: &bayeuxAdvice{Reconnect: reconnectHandshake},
+			})
+			break
+		}
+		for _, data := range f.pending {
+			replies = append(replies, bayeuxMessage{Channel: NippouChangeEventChannel, Data: data})
+		}
+		f.pending = nil
+		replies = append(replies, bayeuxMessage{
+			Channel: metaConnect, ClientID: msg.ClientID, Successful: true,
+			Advice: &bayeuxAdvice{Reconnect: reconnectRetry, Interval: 1},
+		})
+	case metaDisconnect:
+		replies = append(replies, bayeuxMessage{Channel: metaDisconnect, Successful: true})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(replies)
+}
+
+func newStreamingTestClient(server *httptest.Server, store ReplayStore) *StreamingClient {
+	config := DefaultConfig(server.URL)
+	client := NewClient(config, server.Client(), &MockTokenProvider{Token: "test-token"})
+	streaming := DefaultStreamingConfig()
+	streaming.RetryDelay = time.Millisecond
+	return NewStreamingClient(client, store, streaming).WithHTTPClient(server.Client())
+}
+
+// collect runs the client until n events were handled.
+func collect(t *testing.T, s *StreamingClient, n int) ([]*NippouChangeEvent, error) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var events []*NippouChangeEvent
+	err := s.Run(ctx, func(ctx context.Context, e *NippouChangeEvent) error {
+		events = append(events, e)
+		if len(events) == n {
+			cancel()
+		}
+		return nil
+	})
+	return events, err
+}
+
+const (
+	createPayload = `{"ChangeEventHeader":{"entityName":"Nippou__c","recordIds":["a0B000000000001"],` +
+		`"changeType":"CREATE","commitTimestamp":1705280400000,"commitUser":"005000000000001",` +
+		`"changedFields":[]},"Date__c":"2024-01-15","Content__c":"訪問報告","TagSet__c":"sales;visit","VoiceEnabled__c":false}`
+	updatePayload = `{"ChangeEventHeader":{"entityName":"Nippou__c","recordIds":["a0B000000000001"],` +
+		`"changeType":"UPDATE","commitTimestamp":1705284000000,"changedFields":["Content__c","LastModifiedDate"]},` +
+		`"Content__c":"訪問報告 (追記)","Date__c":null}`
+)
+
+// ============================================================================
+// StreamingClient Tests
+// ============================================================================
+
+func TestStreamingClient_DecodesChangeEvents(t *testing.T) {
+	fake, server := newFakeBayeux(t)
+	store := NewFileReplayStore(filepath.Join(t.TempDir(), "replay.json"))
+	fake.publish(11, createPayload)
+	fake.publish(12, updatePayload)
+
+	events, err := collect(t, newStreamingTestClient(server, store), 2)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run() error = %v, want context.Canceled", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("handled %d events, want 2", len(events))
+	}
+
+	created := events[0]
+	if created.ReplayID != 11 || created.Header.ChangeType != CDCCreate || created.Fields.ID != "a0B000000000001" ||
+		created.Fields.Content != "訪問報告" || created.Fields.TagSet != "sales;visit" || created.Fields.Date != "2024-01-15" {
+		t.Errorf("created = %+v", created)
+	}
+	if want := time.Date(2024, 1, 15, 1, 0, 0, 0, time.UTC); !created.Header.CommitTime().Equal(want) {
+		t.Errorf("CommitTime() = %v, want %v", created.Header.CommitTime(), want)
+	}
+	updated := events[1]
+	if updated.Header.ChangeType != CDCUpdate || updated.Fields.Content != "訪問報告 (追記)" || updated.Fields.Date != "" ||
+		len(updated.Header.ChangedFields) != 2 || updated.Header.ChangedFields[0] != "Content__c" {
+		t.Errorf("updated = %+v", updated)
+	}
+
+	if id, ok, err := store.LoadReplayID(NippouChangeEventChannel); err != nil || !ok || id != 12 {
+		t.Errorf("stored replay ID = %d, %v, %v; want 12", id, ok, err)
+	}
+	if !fake.cookieSeen || fake.unauthorized {
+		t.Errorf("cookieSeen = %v, unauthorized = %v", fake.cookieSeen, fake.unauthorized)
+	}
+	if len(fake.replays) != 1 || fake.replays[0] != ReplayNewEvents {
+		t.Errorf("subscribed from %v, want [-1]", fake.replays)
+	}
+}
+
+func TestStreamingClient_ResumesFromStoredReplayID(t *testing.T) {
+	fake, server := newFakeBayeux(t)
+	store := NewFileReplayStore(filepath.Join(t.TempDir(), "replay.json"))
+	if err := store.SaveReplayID(NippouChangeEventChannel, 42); err != nil {
+		t.Fatalf("SaveReplayID() error = %v", err)
+	}
+	fake.publish(43, updatePayload)
+
+	if _, err := collect(t, newStreamingTestClient(server, store), 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(fake.replays) != 1 || fake.replays[0] != 42 {
+		t.Errorf("subscribed from %v, want [42]", fake.replays)
+	}
+}
+
+func TestStreamingClient_RehandshakesExpiredSession(t *testing.T) {
+	fake, server := newFakeBayeux(t)
+	store := NewFileReplayStore(filepath.Join(t.TempDir(), "replay.json"))
+	fake.expire = true
+	fake.publish(7, createPayload)
+
+	events, err := collect(t, newStreamingTestClient(server, store), 1)
+	if !errors.Is(err, context.Canceled) || len(events) != 1 {
+		t.Fatalf("Run() = %d events, %v", len(events), err)
+	}
+	if fake.sessions != 2 || len(fake.replays) != 2 {
+		t.Errorf("sessions = %d, subscribes = %d; want 2 each", fake.sessions, len(fake.replays))
+	}
+}
+
+func TestStreamingClient_RetriesUnavailableHandshake(t *testing.T) {
+	fake, server := newFakeBayeux(t)
+	fake.expire = true
+	fake.failHandshake = 2
+	fake.publish(7, createPayload)
+
+	events, err := collect(t, newStreamingTestClient(server, nil), 1)
+	if !errors.Is(err, context.Canceled) || len(events) != 1 {
+		t.Fatalf("Run() = %d events, %v; want to recover from the 503", len(events), err)
+	}
+	if fake.handshakes != 3 || fake.sessions != 2 {
+		t.Errorf("handshakes = %d, sessions = %d; want 3 and 2", fake.handshakes, fake.sessions)
+	}
+}
+
+func TestStreamingClient_UnauthorizedHandshakeIsFatal(t *testing.T) {
+	var handshakes int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handshakes++
+		http.Error(w, `[{"errorCode":"INVALID_SESSION_ID","message":"Session expired or invalid"}]`, http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := newStreamingTestClient(server, nil).Run(ctx, func(ctx context.Context, e *NippouChangeEvent) error { return nil })
+	var streamErr *StreamingError
+	if !errors.As(err, &streamErr) || streamErr.Transient || streamErr.Channel != metaHandshake {
+		t.Fatalf("Run() error = %v, want a permanent handshake StreamingError", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("cause = %v, want the 401 APIError", err)
+	}
+	if handshakes != 1 {
+		t.Errorf("handshakes = %d, want 1", handshakes)
+	}
+}
+
+func TestStreamingClient_HandlerErrorKeepsReplayID(t *testing.T) {
+	fake, server := newFakeBayeux(t)
+	store := NewFileReplayStore(filepath.Join(t.TempDir(), "replay.json"))
+	fake.publish(5, createPayload)
+	failure := errors.New("database locked")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := newStreamingTestClient(server, store).Run(ctx, func(ctx context.Context, e *NippouChangeEvent) error {
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("Run() error = %v, want handler error", err)
+	}
+	if _, ok, _ := store.LoadReplayID(NippouChangeEventChannel); ok {
+		t.Error("replay ID stored for an unhandled event")
+	}
+}
+
+func TestStreamingClient_HandshakeRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]bayeuxMessage{{Channel: metaHandshake, Error: "403::Handshake denied"}})
+	}))
+	defer server.Close()
+
+	err := newStreamingTestClient(server, nil).Run(context.Background(), func(ctx context.Context, e *NippouChangeEvent) error { return nil })
+	var streamErr *StreamingError
+	if !errors.As(err, &streamErr) || streamErr.Channel != metaHandshake {
+		t.Errorf("Run() error = %v, want handshake StreamingError", err)
+	}
+}
@@ -0,0 +1,164 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// TagMigrator - Legacy Tags__c to TagSet__c Migration
+// ============================================================================
+
+// TagMigrationConfig holds configuration for TagMigrator.
+type TagMigrationConfig struct {
+	BatchSize int  // Records fetched per query
+	DryRun    bool // Report what would change without writing
+}
+
+// DefaultTagMigrationConfig returns sensible default configuration.
+func DefaultTagMigrationConfig() *TagMigrationConfig {
+	return &TagMigrationConfig{
+		BatchSize: 200,
+	}
+}
+
+// TagMigrationFailure records a record that could not be migrated.
+type TagMigrationFailure struct {
+	RecordID string
+	Cause    error
+}
+
+// TagMigrationReport summarizes a migration run.
+type TagMigrationReport struct {
+	Scanned     int                   // Records without TagSet__c that were examined
+	Migrated    int                   // Records whose TagSet__c was (or would be) written
+	Empty       int                   // Records with no legacy tags to migrate
+	DroppedTags map[string][]string   // Legacy tags rejected by nippou.ParseTag, by record ID
+	Failures    []TagMigrationFailure // Records whose update failed
+}
+
+// TagMigrator copies comma-separated Tags__c values into the TagSet__c
+// multi-select picklist. Tags are normalized and deduplicated like
+// nippou.ParseTag, so migrated records match exactly via INCLUDES.
+// The migration is idempotent: only records with an empty TagSet__c are read.
+type TagMigrator struct {
+	client *Client
+	config *TagMigrationConfig
+}
+
+// NewTagMigrator creates a TagMigrator. A nil config uses DefaultTagMigrationConfig.
+func NewTagMigrator(client *Client, config *TagMigrationConfig) *TagMigrator {
+	if config == nil {
+		config = DefaultTagMigrationConfig()
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultTagMigrationConfig().BatchSize
+	}
+	return &TagMigrator{client: client, config: config}
+}
+
+// tagMigrationRecord is the subset of Nippou__c read by the migrator.
+type tagMigrationRecord struct {
+	ID   string `json:"Id"`
+	Tags string `json:"Tags__c"`
+}
+
+// tagMigrationQueryResult is a SOQL response page of tagMigrationRecord.
+type tagMigrationQueryResult struct {
+	TotalSize int                  `json:"totalSize"`
+	Done      bool                 `json:"done"`
+	Records   []tagMigrationRecord `json:"records"`
+}
+
+// Run migrates every unmigrated record, paging by Id so records that are
+// skipped (or fail) are never fetched twice in the same run.
+func (m *TagMigrator) Run(ctx context.Context) (*TagMigrationReport, error) {
+	report := &TagMigrationReport{DroppedTags: make(map[string][]string)}
+	lastID := ""
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		conditions := []Condition{Eq("TagSet__c", NullLiteral())}
+		if lastID != "" {
+			conditions = append(conditions, Gt("Id", StringLiteral(lastID)))
+		}
+		soql, err := NewQuery(NippouObjectName).
+			Select("Id", "Tags__c").
+			Where(conditions...).
+			OrderByAsc("Id").
+			Limit(m.config.BatchSize).
+			Build()
+		if err != nil {
+			return report, err
+		}
+
+		var page tagMigrationQueryResult
+		if err := m.client.Query(ctx, url.QueryEscape(soql), &page); err != nil {
+			return report, &RepositoryError{Operation: "MigrateTags", Cause: err}
+		}
+
+		for _, record := range page.Records {
+			lastID = record.ID
+			m.migrateRecord(ctx, record, report)
+		}
+
+		if len(page.Records) < m.config.BatchSize {
+			return report, nil
+		}
+	}
+}
+
+// migrateRecord normalizes one record's legacy tags and writes TagSet__c.
+func (m *TagMigrator) migrateRecord(ctx context.Context, record tagMigrationRecord, report *TagMigrationReport) {
+	report.Scanned++
+
+	values, dropped := NormalizeLegacyTags(record.Tags)
+	if len(dropped) > 0 {
+		report.DroppedTags[record.ID] = dropped
+	}
+	if len(values) == 0 {
+		report.Empty++
+		return
+	}
+
+	if !m.config.DryRun {
+		payload := map[string]interface{}{"TagSet__c": JoinMultiPicklist(values)}
+		if err := m.client.UpdateSObject(ctx, NippouObjectName, record.ID, payload); err != nil {
+			report.Failures = append(report.Failures, TagMigrationFailure{RecordID: record.ID, Cause: err})
+			return
+		}
+	}
+	report.Migrated++
+}
+
+// NormalizeLegacyTags parses a comma-separated Tags__c value into normalized,
+// deduplicated tag values, returning separately the entries nippou.ParseTag
+// rejects. Reserved words are kept: they are existing data, not new tags.
+func NormalizeLegacyTags(legacy string) (values, dropped []string) {
+	seen := make(map[string]bool)
+	for _, raw := range SplitLegacyTags(legacy) {
+		tag, err := nippou.ParseTag(raw)
+		if err != nil {
+			dropped = append(dropped, raw)
+			continue
+		}
+		if seen[tag.String()] {
+			continue
+		}
+		seen[tag.String()] = true
+		values = append(values, tag.String())
+	}
+	return values, dropped
+}
+
+// String summarizes the report for logs.
+func (r *TagMigrationReport) String() string {
+	return fmt.Sprintf("scanned=%d migrated=%d empty=%d dropped=%d failed=%d",
+		r.Scanned, r.Migrated, r.Empty, len(r.DroppedTags), len(r.Failures))
+}
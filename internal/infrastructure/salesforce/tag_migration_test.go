@@ -0,0 +1,273 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Tag Query Tests
+// ============================================================================
+
+// captureQuery returns a mock client that records the decoded SOQL of each query.
+func captureQuery(queries *[]string) *MockHTTPClient {
+	return &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			q, _ := url.QueryUnescape(req.URL.RawQuery)
+			*queries = append(*queries, strings.TrimPrefix(q, "q="))
+			return newMockResponse(200, QueryResult{Done: true}), nil
+		},
+	}
+}
+
+func TestNippouRepository_FindByTag_ExactIncludes(t *testing.T) {
+	var queries []string
+	repo := NewNippouRepository(newTestClient(captureQuery(&queries)))
+
+	if _, err := repo.FindByTag(" Sales "); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(queries[0], "WHERE TagSet__c INCLUDES ('sales')") {
+		t.Errorf("expected exact INCLUDES match: %s", queries[0])
+	}
+	if strings.Contains(queries[0], "LIKE") {
+		t.Errorf("substring matching must not be used: %s", queries[0])
+	}
+	if !strings.HasPrefix(queries[0], "SELECT Id, Date__c, Content__c,") {
+		t.Errorf("expected SELECT list derived from NippouSF: %s", queries[0])
+	}
+}
+
+func TestNippouRepository_FindByTags_AnyAndAll(t *testing.T) {
+	var queries []string
+	repo := NewNippouRepository(newTestClient(captureQuery(&queries)))
+
+	if _, err := repo.FindByTags([]string{"sales", "visit"}, TagMatchAny); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := repo.FindByTags([]string{"sales", "visit"}, TagMatchAll); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(queries[0], "TagSet__c INCLUDES ('sales', 'visit')") {
+		t.Errorf("unexpected ANY query: %s", queries[0])
+	}
+	if !strings.Contains(queries[1], "TagSet__c INCLUDES ('sales;visit')") {
+		t.Errorf("unexpected ALL query: %s", queries[1])
+	}
+}
+
+func TestNippouRepository_FindByTags_InvalidTag(t *testing.T) {
+	var queries []string
+	repo := NewNippouRepository(newTestClient(captureQuery(&queries)))
+
+	for _, tags := range [][]string{nil, {"a;b"}, {"50%"}, {""}} {
+		if _, err := repo.FindByTags(tags, TagMatchAny); err == nil {
+			t.Errorf("expected error for %q", tags)
+		}
+	}
+	if len(queries) != 0 {
+		t.Errorf("no query expected, got %v", queries)
+	}
+}
+
+// ============================================================================
+// Tag Mapping Tests
+// ============================================================================
+
+func TestFromDomain_DualWritesTags(t *testing.T) {
+	tag1, _ := nippou.NewTag("sales")
+	tag2, _ := nippou.NewTag("visit")
+	n, err := nippou.NewNippouBuilder("2024-01-15", "content").WithTags([]nippou.Tag{tag1, tag2}).Build()
+	if err != nil {
+		t.Fatalf("failed to build Nippou: %v", err)
+	}
+
+	payload := FromDomain(n).ToCreatePayload()
+	if payload["TagSet__c"] != "sales;visit" {
+		t.Errorf("unexpected TagSet__c: %v", payload["TagSet__c"])
+	}
+	if payload["Tags__c"] != "sales,visit" {
+		t.Errorf("unexpected Tags__c: %v", payload["Tags__c"])
+	}
+}
+
+func TestNippouSF_ToDomain_TagSources(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     string
+		tagSet   string
+		expected []string
+	}{
+		{"multi-select picklist", "ignored", "sales;visit", []string{"sales", "visit"}},
+		{"legacy fallback", "sales, visit", "", []string{"sales", "visit"}},
+		{"none", "", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf := &NippouSF{
+				ID:      "550e8400-e29b-41d4-a716-446655440000",
+				Date:    "2024-01-15",
+				Content: "content",
+				Tags:    tt.tags,
+				TagSet:  tt.tagSet,
+			}
+			n, err := sf.ToDomain()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(n.TagStrings(), ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("tags = %v, want %v", n.TagStrings(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeLegacyTags(t *testing.T) {
+	values, dropped := NormalizeLegacyTags("Sales, visit,,sales, bad tag!, follow-up")
+	if strings.Join(values, ";") != "sales;visit;follow-up" {
+		t.Errorf("unexpected values: %v", values)
+	}
+	if len(dropped) != 1 || dropped[0] != "bad tag!" {
+		t.Errorf("unexpected dropped: %v", dropped)
+	}
+}
+
+func TestReservedTags_StayReachable(t *testing.T) {
+	nippou.SetReservedTags("all")
+	defer nippou.SetReservedTags()
+
+	var queries []string
+	repo := NewNippouRepository(newTestClient(captureQuery(&queries)))
+	if _, err := repo.FindByTag("ALL"); err != nil {
+		t.Fatalf("FindByTag(reserved) error = %v", err)
+	}
+	if !strings.Contains(queries[0], "TagSet__c INCLUDES ('all')") {
+		t.Errorf("unexpected query: %s", queries[0])
+	}
+
+	values, dropped := NormalizeLegacyTags("all, sales")
+	if strings.Join(values, ";") != "all;sales" || len(dropped) != 0 {
+		t.Errorf("NormalizeLegacyTags() = %v, dropped %v; reserved words must be kept", values, dropped)
+	}
+}
+
+// ============================================================================
+// TagMigrator Tests
+// ============================================================================
+
+// migrationServer serves unmigrated records in Id order and records updates.
+type migrationServer struct {
+	records []tagMigrationRecord
+	updates map[string]string
+	failIDs map[string]bool
+	queries []string
+}
+
+func (s *migrationServer) Do(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet:
+		q, _ := url.QueryUnescape(req.URL.RawQuery)
+		s.queries = append(s.queries, q)
+		cursor := lastIDFromQuery(q)
+		var page []tagMigrationRecord
+		for _, r := range s.records {
+			if _, migrated := s.updates[r.ID]; migrated || r.ID <= cursor {
+				continue
+			}
+			page = append(page, r)
+			if len(page) == 2 {
+				break
+			}
+		}
+		return newMockResponse(200, tagMigrationQueryResult{Done: true, Records: page}), nil
+	case http.MethodPatch:
+		id := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+		if s.failIDs[id] {
+			return newMockResponse(400, []sfErrorResponse{{Message: "bad value", ErrorCode: "INVALID_OR_NULL_FOR_RESTRICTED_PICKLIST"}}), nil
+		}
+		body, _ := io.ReadAll(req.Body)
+		var payload map[string]string
+		_ = json.Unmarshal(body, &payload)
+		s.updates[id] = payload["TagSet__c"]
+		return newMockResponse(204, nil), nil
+	}
+	return newMockResponse(405, nil), nil
+}
+
+// lastIDFromQuery extracts the paging cursor from "Id > 'X'".
+func lastIDFromQuery(q string) string {
+	_, after, ok := strings.Cut(q, "Id > '")
+	if !ok {
+		return ""
+	}
+	id, _, _ := strings.Cut(after, "'")
+	return id
+}
+
+func newMigrationServer() *migrationServer {
+	return &migrationServer{
+		records: []tagMigrationRecord{
+			{ID: "a01", Tags: "sales,visit"},
+			{ID: "a02", Tags: ""},
+			{ID: "a03", Tags: "Sales, bad tag!"},
+			{ID: "a04", Tags: "follow-up"},
+			{ID: "a05", Tags: "sales"},
+		},
+		updates: make(map[string]string),
+		failIDs: map[string]bool{"a04": true},
+	}
+}
+
+func TestTagMigrator_Run(t *testing.T) {
+	server := newMigrationServer()
+	config := DefaultConfig("https://test.salesforce.com")
+	config.MaxRetries = 0
+	client := NewClient(config, server, &MockTokenProvider{Token: "test-token"})
+
+	report, err := NewTagMigrator(client, &TagMigrationConfig{BatchSize: 2}).Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Scanned != 5 || report.Migrated != 3 || report.Empty != 1 || len(report.Failures) != 1 {
+		t.Errorf("unexpected report: %s", report)
+	}
+	if report.Failures[0].RecordID != "a04" {
+		t.Errorf("unexpected failure: %+v", report.Failures[0])
+	}
+	if got := report.DroppedTags["a03"]; len(got) != 1 || got[0] != "bad tag!" {
+		t.Errorf("unexpected dropped tags: %v", report.DroppedTags)
+	}
+	if server.updates["a01"] != "sales;visit" || server.updates["a03"] != "sales" {
+		t.Errorf("unexpected updates: %v", server.updates)
+	}
+	if !strings.Contains(server.queries[0], "TagSet__c = null") {
+		t.Errorf("expected only unmigrated records to be read: %s", server.queries[0])
+	}
+}
+
+func TestTagMigrator_DryRun(t *testing.T) {
+	server := newMigrationServer()
+	config := DefaultConfig("https://test.salesforce.com")
+	config.MaxRetries = 0
+	client := NewClient(config, server, &MockTokenProvider{Token: "test-token"})
+
+	report, err := NewTagMigrator(client, &TagMigrationConfig{BatchSize: 2, DryRun: true}).Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(server.updates) != 0 {
+		t.Errorf("dry run must not write, got %v", server.updates)
+	}
+	if report.Migrated != 4 {
+		t.Errorf("expected 4 would-be migrations, got %d", report.Migrated)
+	}
+}
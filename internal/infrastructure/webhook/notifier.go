@@ -0,0 +1,387 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Webhook Headers
+// ============================================================================
+
+// Headers sent with every delivery. The signature is the hex HMAC-SHA256 of
+// "<timestamp>.<body>" keyed with the endpoint secret, prefixed with "sha256=".
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Sign returns the signature header value for body sent at timestamp.
+// Receivers recompute it to verify a delivery.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ============================================================================
+// Configuration
+// ============================================================================
+
+// Endpoint is a webhook receiver.
+type Endpoint struct {
+	Name   string   // Used in dead letters
+	URL    string   // Receiver URL
+	Secret string   // HMAC key; deliveries are unsigned when empty
+	Events []string // Event names to deliver; NippouCreated when empty
+	// Templates maps event names to text/template bodies rendered with
+	// Payload. Events without a template are sent as the Payload JSON.
+	// The "json" function quotes a value for use inside JSON.
+	Templates map[string]string
+	// ContentType is sent with template bodies; application/json when
+	// empty. Payload JSON is always sent as application/json.
+	ContentType string
+}
+
+// Config holds configuration for the notifier.
+type Config struct {
+	Endpoints      []Endpoint
+	MaxAttempts    int           // Attempts per delivery before dead-lettering
+	RetryBaseDelay time.Duration // Delay after the first failure; doubles per attempt
+	RetryMaxDelay  time.Duration // Upper bound for the delay
+	DeadLetterPath string        // JSON Lines file for failed deliveries; none when empty
+	Now            func() time.Time
+}
+
+// DefaultConfig returns sensible default retry configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		MaxAttempts:    5,
+		RetryBaseDelay: time.Second,
+		RetryMaxDelay:  time.Minute,
+		Now:            time.Now,
+	}
+}
+
+// ============================================================================
+// Payload - Template Data
+// ============================================================================
+
+// Payload is the data rendered into webhook bodies. Fields an event does not
+// carry are left empty.
+type Payload struct {
+	Event      string    `json:"event"`
+	NippouID   string    `json:"nippouId"`
+	OccurredAt time.Time `json:"occurredAt"`
+	Date       string    `json:"date,omitempty"`
+	Content    string    `json:"content,omitempty"`
+	Tags       []string  `json:"tags,omitempty"`
+}
+
+// payloadOf extracts the template data from e.
+func payloadOf(e nippou.Event) Payload {
+	p := Payload{
+		Event:      e.EventName(),
+		NippouID:   e.AggregateID().String(),
+		OccurredAt: e.OccurredAt().UTC(),
+	}
+	switch ev := e.(type) {
+	case nippou.NippouCreated:
+		p.Date = ev.Date.String()
+		p.Content = ev.Content
+		for _, t := range ev.Tags {
+			p.Tags = append(p.Tags, t.String())
+		}
+	case nippou.ContentUpdated:
+		p.Content = ev.Current
+	case nippou.TagAdded:
+		p.Tags = []string{ev.Tag.String()}
+	case nippou.TagRemoved:
+		p.Tags = []string{ev.Tag.String()}
+	}
+	return p
+}
+
+// templateFuncs are available in body templates.
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// ============================================================================
+// Notifier - Delivers Events to Webhook Endpoints
+// ============================================================================
+
+// endpoint is an Endpoint with parsed templates.
+type endpoint struct {
+	Endpoint
+	events    map[string]bool
+	templates map[string]*template.Template
+}
+
+// Notifier posts domain events to webhook endpoints. Its Handle method is an
+// nippou.EventHandler; subscribe it to the dispatcher the use cases publish
+// to. Retries sleep on the calling goroutine, so use an asynchronous
+// dispatcher to keep them off the request path.
+type Notifier struct {
+	httpClient *http.Client
+	endpoints  []endpoint
+	config     *Config
+	mu         sync.Mutex // serializes dead-letter writes
+}
+
+// NewNotifier creates a notifier, parsing every endpoint template up front.
+func NewNotifier(config *Config, httpClient *http.Client) (*Notifier, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 1
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	n := &Notifier{httpClient: httpClient, config: config}
+	for _, ep := range config.Endpoints {
+		if ep.URL == "" {
+			return nil, fmt.Errorf("webhook %q: URL is required", ep.Name)
+		}
+		parsed := endpoint{Endpoint: ep, events: make(map[string]bool), templates: make(map[string]*template.Template)}
+		names := ep.Events
+		if len(names) == 0 {
+			names = []string{nippou.EventNippouCreated}
+		}
+		for _, name := range names {
+			parsed.events[name] = true
+		}
+		for name, text := range ep.Templates {
+			tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+			if err != nil {
+				return nil, fmt.Errorf("webhook %q: template for %s: %w", ep.Name, name, err)
+			}
+			parsed.templates[name] = tmpl
+		}
+		n.endpoints = append(n.endpoints, parsed)
+	}
+	return n, nil
+}
+
+// Handle delivers e to every endpoint subscribed to it. Deliveries that still
+// fail after the last attempt are written to the dead-letter file; the
+// returned error joins the failures.
+func (n *Notifier) Handle(ctx context.Context, e nippou.Event) error {
+	payload := payloadOf(e)
+	var errs []error
+	for i := range n.endpoints {
+		ep := &n.endpoints[i]
+		if !ep.events[payload.Event] {
+			continue
+		}
+		body, err := ep.render(payload)
+		if err == nil {
+			err = n.deliver(ctx, ep, payload, body)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("webhook %q: %w", ep.Name, err))
+			if dlErr := n.deadLetter(ep, payload, body, err); dlErr != nil {
+				errs = append(errs, dlErr)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// render builds the request body for payload.
+func (ep *endpoint) render(payload Payload) ([]byte, error) {
+	tmpl, ok := ep.templates[payload.Event]
+	if !ok {
+		return json.Marshal(payload)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, payload); err != nil {
+		return nil, fmt.Errorf("render template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// contentType returns the Content-Type of the body sent for event.
+func (ep *endpoint) contentType(event string) string {
+	if _, ok := ep.templates[event]; ok && ep.ContentType != "" {
+		return ep.ContentType
+	}
+	return "application/json"
+}
+
+// deliver posts body, retrying network errors, 429 and 5xx with backoff.
+func (n *Notifier) deliver(ctx context.Context, ep *endpoint, payload Payload, body []byte) error {
+	delivery := nippou.NewID().String()
+	var lastErr error
+	for attempt := 1; attempt <= n.config.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			if err := sleep(ctx, n.delay(attempt-1)); err != nil {
+				return err
+			}
+		}
+		retryable, err := n.post(ctx, ep, payload.Event, delivery, body)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !retryable {
+			break
+		}
+	}
+	return lastErr
+}
+
+// post sends one attempt and reports whether a failure may be retried.
+func (n *Notifier) post(ctx context.Context, ep *endpoint, event, delivery string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	timestamp := n.config.Now().Unix()
+	req.Header.Set("Content-Type", ep.contentType(event))
+	req.Header.Set(HeaderEvent, event)
+	req.Header.Set(HeaderDelivery, delivery)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	if ep.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(ep.Secret, timestamp, body))
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retryable, fmt.Errorf("receiver returned %s", resp.Status)
+}
+
+// delay returns the backoff after the given number of failed attempts.
+func (n *Notifier) delay(failures int) time.Duration {
+	d := n.config.RetryBaseDelay
+	for i := 1; i < failures; i++ {
+		d *= 2
+		if n.config.RetryMaxDelay > 0 && d >= n.config.RetryMaxDelay {
+			return n.config.RetryMaxDelay
+		}
+	}
+	return d
+}
+
+// ============================================================================
+// Dead Letters
+// ============================================================================
+
+// DeadLetter is a delivery that failed permanently, one JSON object per line
+// in the dead-letter file. The body is kept verbatim for replay in one of
+// Body (JSON), BodyText (other text) or BodyBase64 (bytes that are not
+// UTF-8).
+type DeadLetter struct {
+	Endpoint    string          `json:"endpoint"`
+	URL         string          `json:"url"`
+	Event       string          `json:"event"`
+	NippouID    string          `json:"nippouId"`
+	ContentType string          `json:"contentType"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	BodyText    string          `json:"bodyText,omitempty"`
+	BodyBase64  []byte          `json:"bodyBase64,omitempty"`
+	Error       string          `json:"error"`
+	FailedAt    time.Time       `json:"failedAt"`
+}
+
+// deadLetter appends a failed delivery to the dead-letter file.
+func (n *Notifier) deadLetter(ep *endpoint, payload Payload, body []byte, cause error) error {
+	if n.config.DeadLetterPath == "" {
+		return nil
+	}
+	letter := DeadLetter{
+		Endpoint:    ep.Name,
+		URL:         ep.URL,
+		Event:       payload.Event,
+		NippouID:    payload.NippouID,
+		ContentType: ep.contentType(payload.Event),
+		Error:       cause.Error(),
+		FailedAt:    n.config.Now().UTC(),
+	}
+	switch {
+	case len(body) == 0:
+		// Rendering failed; there is nothing to replay
+	case json.Valid(body):
+		letter.Body = body
+	case utf8.Valid(body):
+		letter.BodyText = string(body)
+	default:
+		letter.BodyBase64 = body
+	}
+	line, err := json.Marshal(letter)
+	if err != nil {
+		return fmt.Errorf("encode dead letter: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(n.config.DeadLetterPath), 0o700); err != nil {
+		return fmt.Errorf("write dead letter: %w", err)
+	}
+	f, err := os.OpenFile(n.config.DeadLetterPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("write dead letter: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write dead letter: %w", err)
+	}
+	return nil
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// ============================================================================
+// Compile-time Interface Compliance Check
+// ============================================================================
+
+var _ nippou.EventHandler = (*Notifier)(nil).Handle
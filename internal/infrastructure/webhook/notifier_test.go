@@ -0,0 +1,238 @@
+package webhook
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/nippou"
+	"salesforce-mcp-server/internal/infrastructure/event"
+)
+
+// receiver is a local webhook endpoint that answers with scripted statuses.
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int // Status per request; 200 once exhausted
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newReceiver(t *testing.T, statuses ...int) (*receiver, *httptest.Server) {
+	r := &receiver{statuses: statuses}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.requests = append(r.requests, req)
+		r.bodies = append(r.bodies, body)
+		status := http.StatusOK
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return r, server
+}
+
+func createdEvents(t *testing.T) []nippou.Event {
+	t.Helper()
+	n, err := nippou.NewNippouBuilder("2024-01-15", "A社訪問\n\"見積\"提出").
+		WithTimeFunc(func() time.Time { return time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC) }).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if err := n.AddTag("sales"); err != nil {
+		t.Fatalf("AddTag() error = %v", err)
+	}
+	return n.PullEvents()
+}
+
+func testConfig(endpoints ...Endpoint) *Config {
+	config := DefaultConfig()
+	config.Endpoints = endpoints
+	config.RetryBaseDelay = time.Millisecond
+	config.Now = func() time.Time { return time.Unix(1705309200, 0) }
+	return config
+}
+
+func TestNotifier_DeliversSignedTemplatedMessage(t *testing.T) {
+	rec, server := newReceiver(t)
+	notifier, err := NewNotifier(testConfig(Endpoint{
+		Name:   "chat",
+		URL:    server.URL,
+		Secret: "s3cret",
+		Templates: map[string]string{
+			nippou.EventNippouCreated: `{"text": {{json (printf "新しい日報 %s: %s" .Date .Content)}}}`,
+		},
+	}), server.Client())
+	if err != nil {
+		t.Fatalf("NewNotifier() error = %v", err)
+	}
+
+	// Delivered through the dispatcher, as CreateUseCase does after Save
+	dispatcher := event.NewSyncDispatcher().Subscribe(notifier.Handle)
+	dispatcher.Dispatch(context.Background(), createdEvents(t))
+
+	if len(rec.requests) != 1 {
+		t.Fatalf("received %d requests, want 1 (TagAdded is not subscribed)", len(rec.requests))
+	}
+	req, body := rec.requests[0], rec.bodies[0]
+	var message struct{ Text string }
+	if err := json.Unmarshal(body, &message); err != nil {
+		t.Fatalf("body %s is not JSON: %v", body, err)
+	}
+	if message.Text != "新しい日報 2024-01-15: A社訪問\n\"見積\"提出" {
+		t.Errorf("text = %q", message.Text)
+	}
+	if req.Header.Get(HeaderEvent) != nippou.EventNippouCreated || req.Header.Get(HeaderDelivery) == "" {
+		t.Errorf("headers = %v", req.Header)
+	}
+	timestamp, _ := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+	if timestamp != 1705309200 || req.Header.Get(HeaderSignature) != Sign("s3cret", timestamp, body) {
+		t.Errorf("signature %q does not verify", req.Header.Get(HeaderSignature))
+	}
+}
+
+func TestNotifier_DefaultPayload(t *testing.T) {
+	rec, server := newReceiver(t)
+	notifier, _ := NewNotifier(testConfig(Endpoint{
+		Name:   "audit",
+		URL:    server.URL,
+		Events: []string{nippou.EventNippouCreated, nippou.EventTagAdded},
+	}), server.Client())
+
+	for _, e := range createdEvents(t) {
+		if err := notifier.Handle(context.Background(), e); err != nil {
+			t.Fatalf("Handle() error = %v", err)
+		}
+	}
+	if len(rec.bodies) != 2 {
+		t.Fatalf("received %d requests, want 2", len(rec.bodies))
+	}
+	var created Payload
+	if err := json.Unmarshal(rec.bodies[0], &created); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if created.Event != nippou.EventNippouCreated || created.Date != "2024-01-15" || created.NippouID == "" {
+		t.Errorf("payload = %+v", created)
+	}
+	if rec.requests[0].Header.Get(HeaderSignature) != "" {
+		t.Error("delivery without a secret should be unsigned")
+	}
+	if got := rec.requests[0].Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+}
+
+func TestNotifier_RetriesThenSucceeds(t *testing.T) {
+	rec, server := newReceiver(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	notifier, _ := NewNotifier(testConfig(Endpoint{Name: "chat", URL: server.URL}), server.Client())
+
+	if err := notifier.Handle(context.Background(), createdEvents(t)[0]); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if len(rec.requests) != 3 {
+		t.Fatalf("attempts = %d, want 3", len(rec.requests))
+	}
+	if rec.requests[0].Header.Get(HeaderDelivery) != rec.requests[2].Header.Get(HeaderDelivery) {
+		t.Error("retries should reuse the delivery ID")
+	}
+}
+
+func TestNotifier_DeadLetters(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		attempts int
+	}{
+		{"server keeps failing", []int{500, 502, 503}, 3},
+		{"client error is not retried", []int{http.StatusBadRequest}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, server := newReceiver(t, tt.statuses...)
+			config := testConfig(Endpoint{Name: "chat", URL: server.URL})
+			config.MaxAttempts = 3
+			config.DeadLetterPath = filepath.Join(t.TempDir(), "dead", "webhooks.jsonl")
+			notifier, _ := NewNotifier(config, server.Client())
+
+			if err := notifier.Handle(context.Background(), createdEvents(t)[0]); err == nil {
+				t.Fatal("Handle() expected error")
+			}
+			if len(rec.requests) != tt.attempts {
+				t.Errorf("attempts = %d, want %d", len(rec.requests), tt.attempts)
+			}
+
+			f, err := os.Open(config.DeadLetterPath)
+			if err != nil {
+				t.Fatalf("dead-letter file: %v", err)
+			}
+			defer f.Close()
+			var letters []DeadLetter
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				var letter DeadLetter
+				if err := json.Unmarshal(scanner.Bytes(), &letter); err != nil {
+					t.Fatalf("bad dead letter %s: %v", scanner.Text(), err)
+				}
+				letters = append(letters, letter)
+			}
+			if len(letters) != 1 || letters[0].Endpoint != "chat" || letters[0].Event != nippou.EventNippouCreated ||
+				letters[0].Error == "" || len(letters[0].Body) == 0 {
+				t.Errorf("dead letters = %+v", letters)
+			}
+		})
+	}
+}
+
+func TestNotifier_DeadLettersTextBody(t *testing.T) {
+	rec, server := newReceiver(t, http.StatusBadRequest)
+	config := testConfig(Endpoint{
+		Name:        "chat",
+		URL:         server.URL,
+		ContentType: "text/plain; charset=utf-8",
+		Templates:   map[string]string{nippou.EventNippouCreated: "新しい日報 {{.Date}}"},
+	})
+	config.DeadLetterPath = filepath.Join(t.TempDir(), "webhooks.jsonl")
+	notifier, _ := NewNotifier(config, server.Client())
+
+	if err := notifier.Handle(context.Background(), createdEvents(t)[0]); err == nil {
+		t.Fatal("Handle() expected error")
+	}
+	if got := rec.requests[0].Header.Get("Content-Type"); got != "text/plain; charset=utf-8" {
+		t.Errorf("Content-Type = %q, want the endpoint's", got)
+	}
+
+	data, err := os.ReadFile(config.DeadLetterPath)
+	if err != nil {
+		t.Fatalf("dead-letter file: %v", err)
+	}
+	var letter DeadLetter
+	if err := json.Unmarshal(data, &letter); err != nil {
+		t.Fatalf("bad dead letter %s: %v", data, err)
+	}
+	if letter.BodyText != string(rec.bodies[0]) || len(letter.Body) != 0 || letter.ContentType != "text/plain; charset=utf-8" {
+		t.Errorf("dead letter = %+v, want the text body for replay", letter)
+	}
+}
+
+func TestNewNotifier_InvalidConfig(t *testing.T) {
+	if _, err := NewNotifier(testConfig(Endpoint{Name: "chat"}), nil); err == nil {
+		t.Error("missing URL should be rejected")
+	}
+	bad := Endpoint{Name: "chat", URL: "http://localhost", Templates: map[string]string{nippou.EventNippouCreated: "{{.Date"}}
+	if _, err := NewNotifier(testConfig(bad), nil); err == nil {
+		t.Error("unparsable template should be rejected")
+	}
+}
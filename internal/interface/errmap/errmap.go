@@ -0,0 +1,195 @@
+// Package errmap translates errors from every layer into one structured,
+// localized payload that transports (MCP, HTTP) can send to clients.
+package errmap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+	"salesforce-mcp-server/internal/infrastructure/salesforce"
+	usecase "salesforce-mcp-server/internal/usecase/nippou"
+)
+
+// ============================================================================
+// Payload - Machine-readable Error
+// ============================================================================
+
+// Stable error codes. Domain validation errors keep their domain codes
+// (VALIDATION_ERROR, INVALID_FORMAT, DUPLICATE_ERROR, LIMIT_EXCEEDED and
+// INVALID_ENCODING).
+const (
+	CodeInvalidInput       = usecase.ErrCodeInvalidInput
+	CodeNotFound           = usecase.ErrCodeNotFound
+	CodeUnauthorized       = "UNAUTHORIZED"
+	CodeForbidden          = "FORBIDDEN"
+	CodeRateLimited        = "RATE_LIMITED"
+	CodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+	CodeUpstreamError      = "UPSTREAM_ERROR"
+	CodeRepositoryError    = usecase.ErrCodeRepositoryError
+	CodeCancelled          = "CANCELLED"
+	CodeTimeout            = "TIMEOUT"
+	CodeInternal           = "INTERNAL_ERROR"
+)
+
+// statusClientClosed is the nginx convention for a request the client gave up.
+const statusClientClosed = 499
+
+// Payload is the transport-neutral form of an error.
+type Payload struct {
+	Code      string `json:"code"`
+	Field     string `json:"field,omitempty"`
+	Message   string `json:"message"` // Localized, for end users
+	Retryable bool   `json:"retryable"`
+	Detail    string `json:"detail,omitempty"` // Original English message, for logs and developers
+	Status    int    `json:"-"`                // Closest HTTP status, for transports that need one
+
+	// Errors lists every problem when validation found more than one; the
+	// payload's own code and field are those of the first.
+	Errors []*Payload `json:"errors,omitempty"`
+}
+
+// Error implements error so a Payload can be returned where errors are.
+func (p *Payload) Error() string {
+	if p.Field != "" {
+		return p.Code + ": " + p.Field + " - " + p.Message
+	}
+	return p.Code + ": " + p.Message
+}
+
+// ============================================================================
+// Translation
+// ============================================================================
+
+// Translate unwraps err to its most specific known cause and returns the
+// payload with a message in lang. It returns nil for a nil error.
+func Translate(err error, lang Language) *Payload {
+	if err == nil {
+		return nil
+	}
+	p := classify(err)
+	p.Message = lang.message(p.Code, p.Field)
+	for _, e := range p.Errors {
+		e.Message = lang.message(e.Code, e.Field)
+	}
+	return p
+}
+
+// classify builds the payload without the localized message. Causes are
+// checked before wrappers: a DomainError inside a DOMAIN_VIOLATION, or an
+// APIError inside a RepositoryError, carries the useful code and field.
+func classify(err error) *Payload {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return &Payload{Code: CodeTimeout, Retryable: true, Detail: err.Error(), Status: http.StatusGatewayTimeout}
+	case errors.Is(err, context.Canceled):
+		return &Payload{Code: CodeCancelled, Detail: err.Error(), Status: statusClientClosed}
+	}
+
+	var list domain.ValidationErrors
+	if errors.As(err, &list) && len(list) > 0 {
+		p := classifyDomainError(list[0])
+		if len(list) > 1 {
+			p.Errors = make([]*Payload, len(list))
+			for i, e := range list {
+				p.Errors[i] = classifyDomainError(e)
+			}
+		}
+		return p
+	}
+
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		return classifyDomainError(domainErr)
+	}
+
+	var apiErr *salesforce.APIError
+	if errors.As(err, &apiErr) {
+		return classifyAPIError(apiErr, operationOf(err))
+	}
+
+	var ucErr *usecase.UseCaseError
+	if errors.As(err, &ucErr) {
+		switch ucErr.Code {
+		case usecase.ErrCodeInvalidInput:
+			return &Payload{Code: CodeInvalidInput, Field: ucErr.Field, Detail: trimField(ucErr.Message, ucErr.Field), Status: http.StatusBadRequest}
+		case usecase.ErrCodeNotFound:
+			return &Payload{Code: CodeNotFound, Detail: ucErr.Message, Status: http.StatusNotFound}
+		case usecase.ErrCodeDomainViolation:
+			return &Payload{Code: domain.ErrCodeValidation, Detail: ucErr.Error(), Status: http.StatusBadRequest}
+		case usecase.ErrCodeContextCancelled:
+			return &Payload{Code: CodeCancelled, Detail: ucErr.Message, Status: statusClientClosed}
+		case usecase.ErrCodeRepositoryError:
+			return &Payload{Code: CodeRepositoryError, Retryable: true, Detail: ucErr.Error(), Status: http.StatusServiceUnavailable}
+		}
+	}
+
+	var repoErr *salesforce.RepositoryError
+	if errors.As(err, &repoErr) {
+		return &Payload{Code: CodeRepositoryError, Retryable: true, Detail: repoErr.Error(), Status: http.StatusServiceUnavailable}
+	}
+
+	return &Payload{Code: CodeInternal, Detail: err.Error(), Status: http.StatusInternalServerError}
+}
+
+// classifyDomainError keeps the domain code and field. A nil receiver is a
+// programming error, not bad input.
+func classifyDomainError(domainErr *domain.DomainError) *Payload {
+	p := &Payload{Code: domainErr.Code, Field: domainErr.Field, Detail: domainErr.Message, Status: http.StatusBadRequest}
+	if domainErr.Code == domain.ErrCodeNilReceiver {
+		p.Code, p.Field, p.Status = CodeInternal, "", http.StatusInternalServerError
+	}
+	return p
+}
+
+// classifyAPIError maps a Salesforce API error by status code. The first
+// field Salesforce blames becomes the payload field.
+func classifyAPIError(apiErr *salesforce.APIError, operation string) *Payload {
+	p := &Payload{Code: CodeUpstreamError, Retryable: apiErr.IsRetryable(), Status: http.StatusBadGateway}
+	switch {
+	case apiErr.IsNotFound():
+		p.Code, p.Status = CodeNotFound, http.StatusNotFound
+	case apiErr.IsUnauthorized():
+		p.Code, p.Status = CodeUnauthorized, http.StatusUnauthorized
+	case apiErr.IsForbidden():
+		p.Code, p.Status = CodeForbidden, http.StatusForbidden
+	case apiErr.IsRateLimited():
+		p.Code, p.Status = CodeRateLimited, http.StatusTooManyRequests
+	case apiErr.IsRetryable():
+		p.Code, p.Status = CodeServiceUnavailable, http.StatusServiceUnavailable
+	case apiErr.StatusCode == http.StatusBadRequest && len(apiErr.Fields) > 0:
+		p.Code, p.Status = CodeInvalidInput, http.StatusBadRequest
+	}
+	if len(apiErr.Fields) > 0 {
+		p.Field = apiErr.Fields[0]
+	}
+
+	detail := apiErr.Message
+	if apiErr.ErrorCode != "" {
+		detail = apiErr.ErrorCode + ": " + detail
+	}
+	if operation != "" {
+		detail = operation + ": " + detail
+	}
+	p.Detail = detail
+	return p
+}
+
+// operationOf returns the repository operation err passed through, if any.
+func operationOf(err error) string {
+	var repoErr *salesforce.RepositoryError
+	if errors.As(err, &repoErr) {
+		return repoErr.Operation
+	}
+	return ""
+}
+
+// trimField drops the "field: " prefix NewInvalidInputError adds.
+func trimField(message, field string) string {
+	if field == "" {
+		return message
+	}
+	return strings.TrimPrefix(message, field+": ")
+}
@@ -0,0 +1,163 @@
+package errmap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+	"salesforce-mcp-server/internal/infrastructure/salesforce"
+	usecase "salesforce-mcp-server/internal/usecase/nippou"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		code      string
+		field     string
+		retryable bool
+		status    int
+		ja        string
+	}{
+		{
+			name:   "domain violation unwraps to the domain error",
+			err:    usecase.NewDomainViolationError(domain.ErrEmptyContent),
+			code:   domain.ErrCodeValidation,
+			field:  "content",
+			status: http.StatusBadRequest,
+			ja:     "本文の値が正しくありません。",
+		},
+		{
+			name:   "invalid input keeps the field",
+			err:    usecase.NewInvalidInputError("endDate", "must not be before startDate"),
+			code:   CodeInvalidInput,
+			field:  "endDate",
+			status: http.StatusBadRequest,
+			ja:     "終了日の値が正しくありません。",
+		},
+		{
+			name:   "not found",
+			err:    usecase.NewNotFoundError("nippou", "abc"),
+			code:   CodeNotFound,
+			status: http.StatusNotFound,
+			ja:     "指定されたデータが見つかりません。",
+		},
+		{
+			name: "rate limited API error through repository and use case",
+			err: usecase.NewRepositoryError(&salesforce.RepositoryError{
+				Operation: "Save",
+				Cause:     fmt.Errorf("create: %w", &salesforce.APIError{StatusCode: 429, ErrorCode: "REQUEST_LIMIT_EXCEEDED"}),
+			}),
+			code:      CodeRateLimited,
+			retryable: true,
+			status:    http.StatusTooManyRequests,
+			ja:        "Salesforce のリクエスト制限に達しました。しばらくしてから再試行してください。",
+		},
+		{
+			name:   "API field error",
+			err:    &salesforce.APIError{StatusCode: 400, ErrorCode: "REQUIRED_FIELD_MISSING", Message: "Required fields are missing", Fields: []string{"Date__c"}},
+			code:   CodeInvalidInput,
+			field:  "Date__c",
+			status: http.StatusBadRequest,
+			ja:     "Date__cの値が正しくありません。",
+		},
+		{
+			name:      "repository error without API error",
+			err:       &salesforce.RepositoryError{Operation: "FindByID", Cause: errors.New("connection reset")},
+			code:      CodeRepositoryError,
+			retryable: true,
+			status:    http.StatusServiceUnavailable,
+			ja:        "日報の読み込みまたは保存に失敗しました。再試行してください。",
+		},
+		{
+			name:      "deadline",
+			err:       usecase.NewRepositoryReadError(context.DeadlineExceeded),
+			code:      CodeTimeout,
+			retryable: true,
+			status:    http.StatusGatewayTimeout,
+			ja:        "リクエストがタイムアウトしました。再試行してください。",
+		},
+		{
+			name:   "unknown error",
+			err:    errors.New("boom"),
+			code:   CodeInternal,
+			status: http.StatusInternalServerError,
+			ja:     "予期しないエラーが発生しました。",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Translate(tt.err, Japanese)
+			if p.Code != tt.code || p.Field != tt.field || p.Retryable != tt.retryable || p.Status != tt.status {
+				t.Errorf("Translate() = %+v, want code %s field %q retryable %v status %d", p, tt.code, tt.field, tt.retryable, tt.status)
+			}
+			if p.Message != tt.ja {
+				t.Errorf("Message = %q, want %q", p.Message, tt.ja)
+			}
+			if p.Detail == "" {
+				t.Error("Detail should carry the original message")
+			}
+		})
+	}
+}
+
+func TestTranslate_EnglishPayload(t *testing.T) {
+	p := Translate(usecase.NewDomainViolationError(domain.ErrInvalidLatitude), English)
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"code":"VALIDATION_ERROR","field":"latitude","message":"Latitude is invalid.","retryable":false,"detail":"must be between -90 and 90"}`
+	if string(data) != want {
+		t.Errorf("payload = %s, want %s", data, want)
+	}
+	if Translate(nil, English) != nil {
+		t.Error("Translate(nil) should be nil")
+	}
+}
+
+func TestTranslate_ValidationErrors(t *testing.T) {
+	var errs domain.ValidationErrors
+	errs.Add(domain.ErrEmptyContent)
+	errs.Add(domain.ErrTagTooLong.WithField("tags[1]"))
+	errs.Add(domain.ErrInvalidLatitude.WithField("location.latitude"))
+
+	p := Translate(usecase.NewDomainViolationError(errs), Japanese)
+	if p.Code != domain.ErrCodeValidation || p.Field != "content" {
+		t.Errorf("payload = %s/%s, want the first error", p.Code, p.Field)
+	}
+	want := []string{"本文の値が正しくありません。", "タグ (2件目)が上限を超えています。", "緯度の値が正しくありません。"}
+	if len(p.Errors) != len(want) {
+		t.Fatalf("len(Errors) = %d, want %d", len(p.Errors), len(want))
+	}
+	for i, e := range p.Errors {
+		if e.Message != want[i] {
+			t.Errorf("Errors[%d].Message = %q, want %q", i, e.Message, want[i])
+		}
+	}
+	if got := English.message(domain.ErrCodeLimitExceeded, "tags[1]"); got != "Tags #2 exceeds the allowed limit." {
+		t.Errorf("English tag message = %q", got)
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	tests := []struct {
+		tags string
+		want Language
+	}{
+		{"ja-JP,ja;q=0.9,en;q=0.8", Japanese},
+		{"fr-FR, en-US;q=0.8", English},
+		{"JA", Japanese},
+		{"", English},
+		{"de", English},
+	}
+	for _, tt := range tests {
+		if got := ParseLanguage(tt.tags); got != tt.want {
+			t.Errorf("ParseLanguage(%q) = %q, want %q", tt.tags, got, tt.want)
+		}
+	}
+}
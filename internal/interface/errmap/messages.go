@@ -0,0 +1,135 @@
+package errmap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Localized Messages
+// ============================================================================
+
+// Language selects the message catalog.
+type Language string
+
+const (
+	English  Language = "en"
+	Japanese Language = "ja"
+)
+
+// ParseLanguage picks the first supported language from a tag list such as
+// an Accept-Language header ("ja-JP,ja;q=0.9,en;q=0.8"). It returns English
+// when nothing matches.
+func ParseLanguage(tags string) Language {
+	for _, tag := range strings.Split(tags, ",") {
+		tag, _, _ = strings.Cut(strings.TrimSpace(tag), ";")
+		base, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		switch Language(base) {
+		case English, Japanese:
+			return Language(base)
+		}
+	}
+	return English
+}
+
+// Messages per code; "%s" is replaced with the field label. Codes that
+// carry no field use messages without a placeholder.
+var messages = map[Language]map[string]string{
+	English: {
+		domain.ErrCodeValidation:      "%s is invalid.",
+		domain.ErrCodeInvalidFormat:   "%s is not in the expected format.",
+		domain.ErrCodeDuplicate:       "%s is already registered.",
+		domain.ErrCodeLimitExceeded:   "%s exceeds the allowed limit.",
+		domain.ErrCodeInvalidEncoding: "%s contains characters that are not valid UTF-8.",
+		CodeInvalidInput:              "%s is invalid.",
+		CodeNotFound:                  "The requested record was not found.",
+		CodeUnauthorized:              "Salesforce authentication failed. Please sign in again.",
+		CodeForbidden:                 "You do not have permission for this Salesforce operation.",
+		CodeRateLimited:               "Salesforce is limiting requests. Please try again later.",
+		CodeServiceUnavailable:        "Salesforce is temporarily unavailable. Please try again later.",
+		CodeUpstreamError:             "Salesforce rejected the request.",
+		CodeRepositoryError:           "The report could not be loaded or saved. Please try again.",
+		CodeCancelled:                 "The request was cancelled.",
+		CodeTimeout:                   "The request timed out. Please try again.",
+		CodeInternal:                  "An unexpected error occurred.",
+	},
+	Japanese: {
+		domain.ErrCodeValidation:      "%sの値が正しくありません。",
+		domain.ErrCodeInvalidFormat:   "%sの形式が正しくありません。",
+		domain.ErrCodeDuplicate:       "%sは既に登録されています。",
+		domain.ErrCodeLimitExceeded:   "%sが上限を超えています。",
+		domain.ErrCodeInvalidEncoding: "%sに UTF-8 として不正な文字が含まれています。",
+		CodeInvalidInput:              "%sの値が正しくありません。",
+		CodeNotFound:                  "指定されたデータが見つかりません。",
+		CodeUnauthorized:              "Salesforce の認証に失敗しました。再度ログインしてください。",
+		CodeForbidden:                 "この Salesforce 操作を行う権限がありません。",
+		CodeRateLimited:               "Salesforce のリクエスト制限に達しました。しばらくしてから再試行してください。",
+		CodeServiceUnavailable:        "Salesforce が一時的に利用できません。しばらくしてから再試行してください。",
+		CodeUpstreamError:             "Salesforce がリクエストを受け付けませんでした。",
+		CodeRepositoryError:           "日報の読み込みまたは保存に失敗しました。再試行してください。",
+		CodeCancelled:                 "リクエストはキャンセルされました。",
+		CodeTimeout:                   "リクエストがタイムアウトしました。再試行してください。",
+		CodeInternal:                  "予期しないエラーが発生しました。",
+	},
+}
+
+// fieldLabels names input fields for end users. Unknown fields, such as
+// Salesforce API names, are shown as is.
+var fieldLabels = map[Language]map[string]string{
+	English: {
+		"content": "Content", "date": "Date", "startDate": "Start date", "endDate": "End date",
+		"week": "Week", "tag": "Tag", "tags": "Tags", "latitude": "Latitude", "longitude": "Longitude",
+		"address": "Address", "modelName": "Voice model name", "id": "ID", "nippouId": "Report ID",
+		"author": "Author", "body": "Comment", "template": "Template", "timeZone": "Time zone",
+	},
+	Japanese: {
+		"content": "本文", "date": "日付", "startDate": "開始日", "endDate": "終了日",
+		"week": "週", "tag": "タグ", "tags": "タグ", "latitude": "緯度", "longitude": "経度",
+		"address": "住所", "modelName": "音声モデル名", "id": "ID", "nippouId": "日報 ID",
+		"author": "作成者", "body": "コメント", "template": "テンプレート", "timeZone": "タイムゾーン",
+	},
+}
+
+// defaultFieldLabel is used by field messages when the error names no field.
+var defaultFieldLabel = map[Language]string{English: "The input", Japanese: "入力"}
+
+// message returns the localized message for code and field.
+func (l Language) message(code, field string) string {
+	catalog, ok := messages[l]
+	if !ok {
+		l, catalog = English, messages[English]
+	}
+	text, ok := catalog[code]
+	if !ok {
+		text = catalog[CodeInternal]
+	}
+	if !strings.Contains(text, "%s") {
+		return text
+	}
+	label := defaultFieldLabel[l]
+	if field != "" {
+		label = l.fieldLabel(field)
+	}
+	return strings.Replace(text, "%s", label, 1)
+}
+
+// fieldLabel names field, which may be an input path: "location.latitude"
+// is labeled as "latitude", and "tags[1]" as "tags" with its 1-based position.
+func (l Language) fieldLabel(field string) string {
+	name := field[strings.LastIndex(field, ".")+1:]
+	name, index, indexed := strings.Cut(name, "[")
+	named, ok := fieldLabels[l][name]
+	if !ok {
+		return field
+	}
+	if n, err := strconv.Atoi(strings.TrimSuffix(index, "]")); indexed && err == nil {
+		return fmt.Sprintf(indexFormat[l], named, n+1)
+	}
+	return named
+}
+
+// indexFormat labels one element of a list field.
+var indexFormat = map[Language]string{English: "%s #%d", Japanese: "%s (%d件目)"}
@@ -0,0 +1,261 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// ============================================================================
+// Logging - slog Records as notifications/message
+// ============================================================================
+
+// LoggingLevel is an MCP log level (RFC 5424 severities).
+type LoggingLevel string
+
+const (
+	LevelDebug     LoggingLevel = "debug"
+	LevelInfo      LoggingLevel = "info"
+	LevelNotice    LoggingLevel = "notice"
+	LevelWarning   LoggingLevel = "warning"
+	LevelError     LoggingLevel = "error"
+	LevelCritical  LoggingLevel = "critical"
+	LevelAlert     LoggingLevel = "alert"
+	LevelEmergency LoggingLevel = "emergency"
+)
+
+// slogLevels maps MCP levels to slog levels. Levels without a slog
+// counterpart sit between and above the standard ones.
+var slogLevels = map[LoggingLevel]slog.Level{
+	LevelDebug:     slog.LevelDebug,
+	LevelInfo:      slog.LevelInfo,
+	LevelNotice:    slog.LevelInfo + 2,
+	LevelWarning:   slog.LevelWarn,
+	LevelError:     slog.LevelError,
+	LevelCritical:  slog.LevelError + 4,
+	LevelAlert:     slog.LevelError + 8,
+	LevelEmergency: slog.LevelError + 12,
+}
+
+// loggingLevelOf returns the highest MCP level not above l.
+func loggingLevelOf(l slog.Level) LoggingLevel {
+	result := LevelDebug
+	for level, sl := range slogLevels {
+		if sl <= l && sl > slogLevels[result] {
+			result = level
+		}
+	}
+	return result
+}
+
+// clientLevel is the level requested with logging/setLevel. Nothing is
+// forwarded until the client sets one.
+type clientLevel struct {
+	set   atomic.Bool
+	level atomic.Int64
+}
+
+func (c *clientLevel) enabled(l slog.Level) bool {
+	return c.set.Load() && int64(l) >= c.level.Load()
+}
+
+// LogHandler is a slog.Handler that writes every record to a base handler
+// (JSON on stderr by default) and also sends records at or above the
+// client's requested level as notifications/message.
+type LogHandler struct {
+	base   slog.Handler
+	server *Server
+	level  *clientLevel
+	attrs  []slog.Attr // Attributes from WithAttrs, already grouped
+	groups []string
+}
+
+// NewLogHandler creates the handler and registers logging/setLevel on s.
+// A nil base writes JSON logs at info level to stderr.
+func NewLogHandler(s *Server, base slog.Handler) *LogHandler {
+	if base == nil {
+		base = slog.NewJSONHandler(os.Stderr, nil)
+	}
+	h := &LogHandler{base: base, server: s, level: &clientLevel{}}
+	s.SetCapability("logging", map[string]interface{}{})
+	s.Register("logging/setLevel", h.setLevel)
+	return h
+}
+
+// setLevel handles logging/setLevel.
+func (h *LogHandler) setLevel(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		Level LoggingLevel `json:"level"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	level, ok := slogLevels[p.Level]
+	if !ok {
+		return nil, NewRPCError(CodeInvalidParams, "unknown log level: %q", p.Level)
+	}
+	h.level.level.Store(int64(level))
+	h.level.set.Store(true)
+	return nil, nil
+}
+
+// Enabled implements slog.Handler.
+func (h *LogHandler) Enabled(ctx context.Context, l slog.Level) bool {
+	return h.level.enabled(l) || h.base.Enabled(ctx, l)
+}
+
+// Handle implements slog.Handler. Failing to notify the client is not an
+// error; the record has still been written to the base handler.
+func (h *LogHandler) Handle(ctx context.Context, r slog.Record) error {
+	var err error
+	if h.base.Enabled(ctx, r.Level) {
+		err = h.base.Handle(ctx, r)
+	}
+	if h.level.enabled(r.Level) {
+		_ = h.server.Notify("notifications/message", map[string]interface{}{
+			"level":  loggingLevelOf(r.Level),
+			"logger": h.server.info.Name,
+			"data":   h.data(r),
+		})
+	}
+	return err
+}
+
+// data converts r to the JSON object sent to the client.
+func (h *LogHandler) data(r slog.Record) map[string]interface{} {
+	data := map[string]interface{}{"msg": r.Message}
+	if !r.Time.IsZero() {
+		data["time"] = r.Time.UTC().Format(time.RFC3339Nano)
+	}
+	for _, a := range h.attrs {
+		addAttr(data, a)
+	}
+	target := data
+	for _, g := range h.groups {
+		// WithAttrs after WithGroup may already have filled the group
+		group, ok := target[g].(map[string]interface{})
+		if !ok {
+			group = make(map[string]interface{})
+			target[g] = group
+		}
+		target = group
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		addAttr(target, a)
+		return true
+	})
+	return data
+}
+
+// addAttr adds a to m, nesting groups and resolving LogValuers.
+func addAttr(m map[string]interface{}, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	switch a.Value.Kind() {
+	case slog.KindGroup:
+		// Merge into an existing group: each WithAttrs call adds its own
+		// copy of the enclosing groups.
+		group := m
+		if a.Key != "" {
+			existing, ok := m[a.Key].(map[string]interface{})
+			if !ok {
+				existing = make(map[string]interface{})
+			}
+			group = existing
+		}
+		for _, ga := range a.Value.Group() {
+			addAttr(group, ga)
+		}
+		if a.Key != "" && len(group) > 0 {
+			m[a.Key] = group
+		}
+	case slog.KindDuration:
+		m[a.Key] = a.Value.Duration().String()
+	case slog.KindTime:
+		m[a.Key] = a.Value.Time().UTC().Format(time.RFC3339Nano)
+	default:
+		if err, ok := a.Value.Any().(error); ok {
+			m[a.Key] = err.Error()
+			return
+		}
+		m[a.Key] = a.Value.Any()
+	}
+}
+
+// WithAttrs implements slog.Handler.
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	clone := *h
+	clone.base = h.base.WithAttrs(attrs)
+	grouped := attrs
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		grouped = []slog.Attr{{Key: h.groups[i], Value: slog.GroupValue(grouped...)}}
+	}
+	clone.attrs = append(append([]slog.Attr(nil), h.attrs...), grouped...)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.base = h.base.WithGroup(name)
+	clone.groups = append(append([]string(nil), h.groups...), name)
+	return &clone
+}
+
+// ============================================================================
+// Progress - notifications/progress
+// ============================================================================
+
+// progressKey is the context key of the request's progress reporter.
+type progressKey struct{}
+
+// progressReporter sends progress for the request that carried token.
+type progressReporter struct {
+	server *Server
+	token  json.RawMessage
+}
+
+// withProgress attaches a reporter when params carry _meta.progressToken.
+func withProgress(ctx context.Context, s *Server, params json.RawMessage) context.Context {
+	var p struct {
+		Meta struct {
+			ProgressToken json.RawMessage `json:"progressToken"`
+		} `json:"_meta"`
+	}
+	if len(params) == 0 || json.Unmarshal(params, &p) != nil || len(p.Meta.ProgressToken) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, progressKey{}, &progressReporter{server: s, token: p.Meta.ProgressToken})
+}
+
+// ReportProgress sends notifications/progress for the request handled under
+// ctx. Progress must increase with each call; total is omitted when zero.
+// It does nothing when the client did not ask for progress.
+func ReportProgress(ctx context.Context, progress, total float64, message string) error {
+	reporter, ok := ctx.Value(progressKey{}).(*progressReporter)
+	if !ok {
+		return nil
+	}
+	params := map[string]interface{}{
+		"progressToken": reporter.token,
+		"progress":      progress,
+	}
+	if total > 0 {
+		params["total"] = total
+	}
+	if message != "" {
+		params["message"] = message
+	}
+	return reporter.server.Notify("notifications/progress", params)
+}
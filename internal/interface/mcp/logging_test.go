@@ -0,0 +1,256 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// lineBuffer collects the messages written by Serve or Notify.
+type lineBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lineBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// messages decodes the written lines.
+func (b *lineBuffer) messages(t *testing.T) []map[string]json.RawMessage {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var result []map[string]json.RawMessage
+	for _, line := range strings.Split(strings.TrimSpace(b.buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(line), &m); err != nil {
+			t.Fatalf("line %s: %v", line, err)
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// serveLines runs Serve over the given messages and returns what it wrote.
+func serveLines(t *testing.T, s *Server, lines ...string) *lineBuffer {
+	t.Helper()
+	out := &lineBuffer{}
+	if err := s.Serve(context.Background(), strings.NewReader(strings.Join(lines, "\n")), out); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+	return out
+}
+
+// ============================================================================
+// Logging Tests
+// ============================================================================
+
+func TestLogHandler_ForwardsAtClientLevel(t *testing.T) {
+	s := NewServer("salesforce-mcp-server", "0.0.0")
+	var stderr bytes.Buffer
+	logger := slog.New(NewLogHandler(s, slog.NewJSONHandler(&stderr, nil))).With("component", "salesforce")
+	s.Register("work", func(ctx context.Context, params json.RawMessage) (interface{}, error) {
+		logger.DebugContext(ctx, "salesforce request", "method", "GET", "status", 200)
+		logger.WarnContext(ctx, "salesforce request failed; retrying", "status", 503, "attempt", 1)
+		return nil, nil
+	})
+
+	out := serveLines(t, s, `{"jsonrpc":"2.0","id":1,"method":"work"}`)
+	for _, m := range out.messages(t) {
+		if string(m["method"]) == `"notifications/message"` {
+			t.Errorf("forwarded %s before logging/setLevel", m["params"])
+		}
+	}
+
+	serveLines(t, s, `{"jsonrpc":"2.0","id":2,"method":"logging/setLevel","params":{"level":"warning"}}`)
+	out = serveLines(t, s, `{"jsonrpc":"2.0","id":3,"method":"work"}`)
+	type message struct {
+		Level  LoggingLevel
+		Logger string
+		Data   map[string]interface{}
+	}
+	var notes []message
+	for _, m := range out.messages(t) {
+		if string(m["method"]) == `"notifications/message"` {
+			var p message
+			if err := json.Unmarshal(m["params"], &p); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			notes = append(notes, p)
+		}
+	}
+	if len(notes) != 1 {
+		t.Fatalf("forwarded %d messages, want 1 (debug is below warning)", len(notes))
+	}
+	note := notes[0]
+	if note.Level != LevelWarning || note.Logger != "salesforce-mcp-server" || note.Data["msg"] != "salesforce request failed; retrying" ||
+		note.Data["status"] != float64(503) || note.Data["component"] != "salesforce" {
+		t.Errorf("notification = %+v", note)
+	}
+
+	// stderr keeps its own level regardless of the client
+	if strings.Contains(stderr.String(), `"level":"DEBUG"`) || strings.Count(stderr.String(), `"level":"WARN"`) != 2 {
+		t.Errorf("stderr = %s", stderr.String())
+	}
+}
+
+func TestLogHandler_GroupsKeepAttrs(t *testing.T) {
+	s := NewServer("test", "0.0.0")
+	h := NewLogHandler(s, slog.NewJSONHandler(io.Discard, nil))
+	tests := []struct {
+		name   string
+		logger *slog.Logger
+		want   string
+	}{
+		{"attrs in group", slog.New(h).WithGroup("req").With("id", 1), `{"msg":"x","req":{"id":1,"k":2}}`},
+		{"attrs before and in group", slog.New(h).With("a", 0).WithGroup("req").With("id", 1), `{"a":0,"msg":"x","req":{"id":1,"k":2}}`},
+		{"nested groups", slog.New(h).WithGroup("req").With("id", 1).WithGroup("sf").With("n", 3), `{"msg":"x","req":{"id":1,"sf":{"k":2,"n":3}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := slog.NewRecord(time.Time{}, slog.LevelInfo, "x", 0)
+			r.AddAttrs(slog.Int("k", 2))
+			data, err := json.Marshal(tt.logger.Handler().(*LogHandler).data(r))
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("data = %s, want %s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogHandler_SetLevelRejectsUnknownLevel(t *testing.T) {
+	s := NewServer("test", "0.0.0")
+	NewLogHandler(s, slog.NewJSONHandler(io.Discard, nil))
+	if _, rpcErr := call(t, s, "logging/setLevel", map[string]string{"level": "verbose"}); rpcErr == nil || rpcErr.Code != CodeInvalidParams {
+		t.Errorf("error = %v, want invalid params", rpcErr)
+	}
+}
+
+func TestLoggingLevelOf(t *testing.T) {
+	tests := []struct {
+		level slog.Level
+		want  LoggingLevel
+	}{
+		{slog.LevelDebug - 4, LevelDebug},
+		{slog.LevelInfo, LevelInfo},
+		{slog.LevelInfo + 2, LevelNotice},
+		{slog.LevelWarn, LevelWarning},
+		{slog.LevelError + 1, LevelError},
+		{slog.LevelError + 20, LevelEmergency},
+	}
+	for _, tt := range tests {
+		if got := loggingLevelOf(tt.level); got != tt.want {
+			t.Errorf("loggingLevelOf(%v) = %q, want %q", tt.level, got, tt.want)
+		}
+	}
+}
+
+// ============================================================================
+// Progress and Cancellation Tests
+// ============================================================================
+
+func TestReportProgress(t *testing.T) {
+	s := NewServer("test", "0.0.0")
+	s.Register("work", func(ctx context.Context, params json.RawMessage) (interface{}, error) {
+		for i := 1; i <= 2; i++ {
+			if err := ReportProgress(ctx, float64(i), 2, "step"); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+
+	out := serveLines(t, s, `{"jsonrpc":"2.0","id":1,"method":"work","params":{"_meta":{"progressToken":"tok-1"}}}`)
+	var progress []string
+	for _, m := range out.messages(t) {
+		if string(m["method"]) == `"notifications/progress"` {
+			progress = append(progress, string(m["params"]))
+		}
+	}
+	if len(progress) != 2 || !strings.Contains(progress[1], `"progressToken":"tok-1"`) || !strings.Contains(progress[1], `"progress":2`) {
+		t.Errorf("progress = %v", progress)
+	}
+
+	// Without a token nothing is sent
+	out = serveLines(t, s, `{"jsonrpc":"2.0","id":2,"method":"work"}`)
+	if msgs := out.messages(t); len(msgs) != 1 || msgs[0]["result"] == nil {
+		t.Errorf("messages = %v, want only the response", msgs)
+	}
+}
+
+func TestServer_CancelledRequest(t *testing.T) {
+	s := NewServer("test", "0.0.0")
+	started := make(chan struct{})
+	cause := make(chan error, 1)
+	s.Register("slow", func(ctx context.Context, params json.RawMessage) (interface{}, error) {
+		close(started)
+		select {
+		case <-ctx.Done():
+			cause <- ctx.Err()
+			return nil, ctx.Err()
+		case <-time.After(5 * time.Second):
+			cause <- nil
+			return "done", nil
+		}
+	})
+
+	in, writer := io.Pipe()
+	out := &lineBuffer{}
+	served := make(chan error, 1)
+	go func() { served <- s.Serve(context.Background(), in, out) }()
+
+	_, _ = io.WriteString(writer, `{"jsonrpc":"2.0","id":"req-7","method":"slow"}`+"\n")
+	<-started
+	_, _ = io.WriteString(writer, `{"jsonrpc":"2.0","method":"notifications/cancelled","params":{"requestId":"req-7","reason":"user"}}`+"\n")
+	writer.Close()
+	if err := <-served; err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+
+	if err := <-cause; !errors.Is(err, context.Canceled) {
+		t.Errorf("handler ctx error = %v, want context.Canceled", err)
+	}
+	if msgs := out.messages(t); len(msgs) != 0 {
+		t.Errorf("cancelled request was answered: %v", msgs)
+	}
+}
+
+func TestServer_CancelBeforeRequestRuns(t *testing.T) {
+	s := NewServer("test", "0.0.0")
+	s.Register("slow", func(ctx context.Context, params json.RawMessage) (interface{}, error) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(5 * time.Second):
+			return "done", nil
+		}
+	})
+
+	// The cancellation directly follows the request, so its goroutine may
+	// run before the request's; it must still find the request.
+	for i := 0; i < 20; i++ {
+		out := serveLines(t, s,
+			`{"jsonrpc":"2.0","id":1,"method":"slow"}`,
+			`{"jsonrpc":"2.0","method":"notifications/cancelled","params":{"requestId":1}}`,
+		)
+		if msgs := out.messages(t); len(msgs) != 0 {
+			t.Fatalf("cancelled request was answered: %v", msgs)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+	usecase "salesforce-mcp-server/internal/usecase/nippou"
+)
+
+// ============================================================================
+// Nippou Prompts - Guided Report Writing
+// ============================================================================
+
+// Prompt names.
+const (
+	PromptWriteNippou   = "write_nippou"
+	PromptSummarizeWeek = "summarize_week"
+	PromptReviewNippou  = "review_nippou"
+)
+
+// Prompt describes a prompt in prompts/list.
+type Prompt struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Arguments   []PromptArgument `json:"arguments,omitempty"`
+}
+
+// PromptArgument describes an argument a client fills in.
+type PromptArgument struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+// PromptMessage is one message of a prompts/get result.
+type PromptMessage struct {
+	Role    string      `json:"role"`
+	Content TextContent `json:"content"`
+}
+
+// TextContent is a plain text content block.
+type TextContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// prompts are listed in this order.
+var prompts = []Prompt{
+	{
+		Name:        PromptWriteNippou,
+		Description: "Draft a daily report, continuing from the previous day's report",
+		Arguments: []PromptArgument{
+			{Name: "date", Description: "Report date (YYYY-MM-DD); today when omitted"},
+			{Name: "notes", Description: "Rough notes on what happened"},
+		},
+	},
+	{
+		Name:        PromptSummarizeWeek,
+		Description: "Summarize the reports of an ISO week",
+		Arguments: []PromptArgument{
+			{Name: "week", Description: "ISO week (YYYY-Www); the current week when omitted"},
+		},
+	},
+	{
+		Name:        PromptReviewNippou,
+		Description: "Review a report for clarity and missing follow-ups",
+		Arguments: []PromptArgument{
+			{Name: "id", Description: "Report ID", Required: true},
+		},
+	},
+}
+
+// Prompts serves guided-writing prompts filled in from stored reports.
+type Prompts struct {
+	reader   *usecase.ReadUseCase
+	location *time.Location
+	now      func() time.Time
+}
+
+// NewPrompts creates the prompt provider. Default dates and weeks are
+// relative to today in domain.DefaultLocation unless WithLocation is used.
+func NewPrompts(reader *usecase.ReadUseCase) *Prompts {
+	return &Prompts{reader: reader, location: domain.DefaultLocation(), now: time.Now}
+}
+
+// WithLocation sets the time zone that defines "today".
+func (p *Prompts) WithLocation(loc *time.Location) *Prompts {
+	if loc != nil {
+		p.location = loc
+	}
+	return p
+}
+
+// WithTimeFunc sets the clock, for tests.
+func (p *Prompts) WithTimeFunc(now func() time.Time) *Prompts {
+	if now != nil {
+		p.now = now
+	}
+	return p
+}
+
+// Register installs the prompts methods and capability on s.
+func (p *Prompts) Register(s *Server) {
+	s.SetCapability("prompts", map[string]interface{}{})
+	s.Register("prompts/list", p.list)
+	s.Register("prompts/get", p.get)
+}
+
+// list returns the available prompts.
+func (p *Prompts) list(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	return map[string]interface{}{"prompts": prompts}, nil
+}
+
+// get renders the named prompt with its arguments.
+func (p *Prompts) get(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req struct {
+		Name      string            `json:"name"`
+		Arguments map[string]string `json:"arguments"`
+	}
+	if err := decodeParams(params, &req); err != nil {
+		return nil, err
+	}
+
+	var (
+		description, text string
+		err               error
+	)
+	switch req.Name {
+	case PromptWriteNippou:
+		description, text, err = p.writeNippou(ctx, req.Arguments)
+	case PromptSummarizeWeek:
+		description, text, err = p.summarizeWeek(ctx, req.Arguments)
+	case PromptReviewNippou:
+		description, text, err = p.reviewNippou(ctx, req.Arguments)
+	default:
+		return nil, NewRPCError(CodeInvalidParams, "unknown prompt: %s", req.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"description": description,
+		"messages": []PromptMessage{
+			{Role: "user", Content: TextContent{Type: "text", Text: text}},
+		},
+	}, nil
+}
+
+// writeNippou asks for a report on the given date, with the previous day's
+// report as context.
+func (p *Prompts) writeNippou(ctx context.Context, args map[string]string) (string, string, error) {
+	date := domain.TodayIn(p.now(), p.location)
+	if s := args["date"]; s != "" {
+		parsed, err := domain.ParseCivilDate(s)
+		if err != nil {
+			return "", "", NewRPCError(CodeInvalidParams, "date: expected YYYY-MM-DD format")
+		}
+		date = parsed
+	}
+	previous, err := p.reader.Execute(ctx, &usecase.ReadInput{Date: date.AddDays(-1).String()})
+	if err != nil {
+		return "", "", rpcErrorOf(err)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Help me write my daily report (nippou) for %s (%s).\n", date, date.Weekday())
+	b.WriteString("Cover the customers visited, what was discussed, results and next actions. ")
+	b.WriteString("Write in the same language as my previous reports and keep it concise.\n")
+	if notes := strings.TrimSpace(args["notes"]); notes != "" {
+		fmt.Fprintf(&b, "\nMy notes for today:\n\n%s\n", notes)
+	}
+	if len(previous.Nippous) > 0 {
+		fmt.Fprintf(&b, "\nYesterday's report, for continuity and open follow-ups:\n")
+		for _, n := range previous.Nippous {
+			writeReport(&b, n)
+		}
+	}
+	return fmt.Sprintf("Daily report for %s", date), b.String(), nil
+}
+
+// summarizeWeek asks for a summary of the reports of an ISO week.
+func (p *Prompts) summarizeWeek(ctx context.Context, args map[string]string) (string, string, error) {
+	week := args["week"]
+	if week == "" {
+		week = domain.TodayIn(p.now(), p.location).ISOWeekString()
+	}
+	output, err := p.reader.Execute(ctx, &usecase.ReadInput{Week: week})
+	if err != nil {
+		return "", "", rpcErrorOf(err)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Summarize my daily reports for %s (%s to %s).\n", week, output.StartDate, output.EndDate)
+	if len(output.Nippous) == 0 {
+		b.WriteString("\nThere are no reports for this week. Point out which business days are missing.\n")
+		return fmt.Sprintf("Weekly summary for %s", week), b.String(), nil
+	}
+	b.WriteString("List the main activities and results, recurring customers or topics, and open follow-ups for next week.\n")
+	for _, n := range output.Nippous {
+		writeReport(&b, n)
+	}
+	return fmt.Sprintf("Weekly summary for %s", week), b.String(), nil
+}
+
+// reviewNippou asks for feedback on one report.
+func (p *Prompts) reviewNippou(ctx context.Context, args map[string]string) (string, string, error) {
+	id := args["id"]
+	if id == "" {
+		return "", "", NewRPCError(CodeInvalidParams, "id: argument is required")
+	}
+	output, err := p.reader.Execute(ctx, &usecase.ReadInput{ID: id})
+	if err != nil {
+		return "", "", rpcErrorOf(err)
+	}
+
+	var b strings.Builder
+	b.WriteString("Review the daily report below. Point out unclear statements, missing facts ")
+	b.WriteString("(who, what, outcome) and follow-ups without an owner or date, then suggest a revised version.\n")
+	for _, n := range output.Nippous {
+		writeReport(&b, n)
+	}
+	return fmt.Sprintf("Review of report %s", id), b.String(), nil
+}
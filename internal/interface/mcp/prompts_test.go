@@ -0,0 +1,131 @@
+package mcp
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+func newPromptServer(t *testing.T) (*Server, []*domain.Nippou) {
+	t.Helper()
+	reader, nippous := newTestReader(t)
+	s := NewServer("test", "0.0.0")
+	NewPrompts(reader).WithLocation(time.UTC).WithTimeFunc(testNow).Register(s)
+	return s, nippous
+}
+
+// getPrompt calls prompts/get and returns the text of the single message.
+func getPrompt(t *testing.T, s *Server, name string, args map[string]string) (string, *RPCError) {
+	t.Helper()
+	result, rpcErr := call(t, s, "prompts/get", map[string]interface{}{"name": name, "arguments": args})
+	if rpcErr != nil {
+		return "", rpcErr
+	}
+	var get struct{ Messages []PromptMessage }
+	if err := json.Unmarshal(result, &get); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(get.Messages) != 1 || get.Messages[0].Role != "user" || get.Messages[0].Content.Type != "text" {
+		t.Fatalf("messages = %+v", get.Messages)
+	}
+	return get.Messages[0].Content.Text, nil
+}
+
+// ============================================================================
+// Prompts Tests
+// ============================================================================
+
+func TestPrompts_List(t *testing.T) {
+	s, _ := newPromptServer(t)
+
+	result, rpcErr := call(t, s, "prompts/list", nil)
+	if rpcErr != nil {
+		t.Fatalf("prompts/list error = %v", rpcErr)
+	}
+	var list struct{ Prompts []Prompt }
+	if err := json.Unmarshal(result, &list); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	var names []string
+	for _, p := range list.Prompts {
+		names = append(names, p.Name)
+	}
+	if strings.Join(names, ",") != "write_nippou,summarize_week,review_nippou" {
+		t.Errorf("prompts = %v", names)
+	}
+}
+
+func TestPrompts_WriteNippouIncludesYesterday(t *testing.T) {
+	s, _ := newPromptServer(t)
+
+	text, rpcErr := getPrompt(t, s, PromptWriteNippou, map[string]string{"date": "2024-01-16", "notes": "C社デモ"})
+	if rpcErr != nil {
+		t.Fatalf("prompts/get error = %v", rpcErr)
+	}
+	if !strings.Contains(text, "2024-01-16 (Tuesday)") || !strings.Contains(text, "C社デモ") ||
+		!strings.Contains(text, "## 2024-01-15") || !strings.Contains(text, "A社訪問\n見積提出") {
+		t.Errorf("text = %q", text)
+	}
+
+	// Defaults to today; there is no report on 2024-01-16
+	text, _ = getPrompt(t, s, PromptWriteNippou, nil)
+	if !strings.Contains(text, "2024-01-17") || strings.Contains(text, "Yesterday") {
+		t.Errorf("text = %q", text)
+	}
+}
+
+func TestPrompts_SummarizeWeek(t *testing.T) {
+	s, _ := newPromptServer(t)
+
+	text, rpcErr := getPrompt(t, s, PromptSummarizeWeek, nil)
+	if rpcErr != nil {
+		t.Fatalf("prompts/get error = %v", rpcErr)
+	}
+	if !strings.Contains(text, "2024-W03 (2024-01-15 to 2024-01-21)") || !strings.Contains(text, "B社打合せ") ||
+		strings.Contains(text, "先週の訪問") {
+		t.Errorf("text = %q", text)
+	}
+
+	text, _ = getPrompt(t, s, PromptSummarizeWeek, map[string]string{"week": "2023-W40"})
+	if !strings.Contains(text, "no reports") {
+		t.Errorf("empty week text = %q", text)
+	}
+}
+
+func TestPrompts_ReviewNippou(t *testing.T) {
+	s, nippous := newPromptServer(t)
+
+	text, rpcErr := getPrompt(t, s, PromptReviewNippou, map[string]string{"id": nippous[2].ID().String()})
+	if rpcErr != nil {
+		t.Fatalf("prompts/get error = %v", rpcErr)
+	}
+	if !strings.Contains(text, "B社打合せ") {
+		t.Errorf("text = %q", text)
+	}
+}
+
+func TestPrompts_Errors(t *testing.T) {
+	s, _ := newPromptServer(t)
+	tests := []struct {
+		name   string
+		prompt string
+		args   map[string]string
+		code   int
+	}{
+		{"unknown prompt", "write_poem", nil, CodeInvalidParams},
+		{"missing id", PromptReviewNippou, nil, CodeInvalidParams},
+		{"unknown id", PromptReviewNippou, map[string]string{"id": domain.NewID().String()}, CodeResourceNotFound},
+		{"bad date", PromptWriteNippou, map[string]string{"date": "yesterday"}, CodeInvalidParams},
+		{"bad week", PromptSummarizeWeek, map[string]string{"week": "W03"}, CodeInvalidParams},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, rpcErr := getPrompt(t, s, tt.prompt, tt.args); rpcErr == nil || rpcErr.Code != tt.code {
+				t.Errorf("error = %v, want code %d", rpcErr, tt.code)
+			}
+		})
+	}
+}
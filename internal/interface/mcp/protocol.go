@@ -0,0 +1,85 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"salesforce-mcp-server/internal/interface/errmap"
+)
+
+// ============================================================================
+// JSON-RPC 2.0 Messages
+// ============================================================================
+
+// JSONRPCVersion is the only protocol version accepted in requests.
+const JSONRPCVersion = "2.0"
+
+// ProtocolVersion is the MCP revision this server implements.
+const ProtocolVersion = "2025-06-18"
+
+// Request is a JSON-RPC request or, when ID is absent, a notification.
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// IsNotification reports whether the request expects no response.
+func (r *Request) IsNotification() bool {
+	return len(r.ID) == 0
+}
+
+// Response is a JSON-RPC response carrying either Result or Error.
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+}
+
+// ============================================================================
+// Errors
+// ============================================================================
+
+// JSON-RPC and MCP error codes.
+const (
+	CodeParseError       = -32700
+	CodeInvalidRequest   = -32600
+	CodeMethodNotFound   = -32601
+	CodeInvalidParams    = -32602
+	CodeInternalError    = -32603
+	CodeResourceNotFound = -32002
+)
+
+// RPCError is the error object of a JSON-RPC response.
+type RPCError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// NewRPCError creates an RPCError with a formatted message.
+func NewRPCError(code int, format string, args ...interface{}) *RPCError {
+	return &RPCError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// rpcErrorOf translates err with errmap. The payload is sent as the error
+// data so clients get the same code, field and retryable flag as any other
+// transport.
+func rpcErrorOf(err error) error {
+	p := errmap.Translate(err, errmap.English)
+	code := CodeInternalError
+	switch {
+	case p.Status == http.StatusNotFound:
+		code = CodeResourceNotFound
+	case p.Status == http.StatusBadRequest:
+		code = CodeInvalidParams
+	}
+	return &RPCError{Code: code, Message: p.Message, Data: p}
+}
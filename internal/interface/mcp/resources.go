@@ -0,0 +1,237 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+	usecase "salesforce-mcp-server/internal/usecase/nippou"
+)
+
+// ============================================================================
+// Nippou Resources - nippou://date, nippou://id and nippou://week
+// ============================================================================
+
+// Resource URI prefixes. The remainder is a YYYY-MM-DD date, a report ID or
+// an ISO week such as 2024-W03.
+const (
+	DateURIPrefix = "nippou://date/"
+	IDURIPrefix   = "nippou://id/"
+	WeekURIPrefix = "nippou://week/"
+)
+
+// MIME types of the contents returned by resources/read.
+const (
+	MIMEMarkdown = "text/markdown"
+	MIMEJSON     = "application/json"
+)
+
+// recentDays is how far back resources/list looks for reports.
+const recentDays = 7
+
+// Resource describes a concrete resource in resources/list.
+type Resource struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MIMEType    string `json:"mimeType,omitempty"`
+}
+
+// ResourceTemplate describes a parameterized resource in
+// resources/templates/list.
+type ResourceTemplate struct {
+	URITemplate string `json:"uriTemplate"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MIMEType    string `json:"mimeType,omitempty"`
+}
+
+// ResourceContents is one rendering of a resource in resources/read.
+type ResourceContents struct {
+	URI      string `json:"uri"`
+	MIMEType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+// resourceTemplates are the URI templates clients can fill in.
+var resourceTemplates = []ResourceTemplate{
+	{URITemplate: DateURIPrefix + "{date}", Name: "nippou-by-date", Description: "Reports for one day (YYYY-MM-DD)", MIMEType: MIMEMarkdown},
+	{URITemplate: IDURIPrefix + "{id}", Name: "nippou-by-id", Description: "A single report by ID", MIMEType: MIMEMarkdown},
+	{URITemplate: WeekURIPrefix + "{week}", Name: "nippou-by-week", Description: "Reports for an ISO week (YYYY-Www), Monday to Sunday", MIMEType: MIMEMarkdown},
+}
+
+// Resources serves reports as MCP resources through the ReadUseCase.
+type Resources struct {
+	reader   *usecase.ReadUseCase
+	location *time.Location
+	now      func() time.Time
+}
+
+// NewResources creates the resource provider. Dates in resources/list are
+// relative to today in domain.DefaultLocation unless WithLocation is used.
+func NewResources(reader *usecase.ReadUseCase) *Resources {
+	return &Resources{reader: reader, location: domain.DefaultLocation(), now: time.Now}
+}
+
+// WithLocation sets the time zone that defines "today".
+func (r *Resources) WithLocation(loc *time.Location) *Resources {
+	if loc != nil {
+		r.location = loc
+	}
+	return r
+}
+
+// WithTimeFunc sets the clock, for tests.
+func (r *Resources) WithTimeFunc(now func() time.Time) *Resources {
+	if now != nil {
+		r.now = now
+	}
+	return r
+}
+
+// Register installs the resources methods and capability on s.
+func (r *Resources) Register(s *Server) {
+	s.SetCapability("resources", map[string]interface{}{})
+	s.Register("resources/list", r.list)
+	s.Register("resources/templates/list", r.templates)
+	s.Register("resources/read", r.read)
+}
+
+// list returns a date resource for each day of the last week with reports,
+// followed by the current and previous ISO weeks.
+func (r *Resources) list(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	today := domain.TodayIn(r.now(), r.location)
+	output, err := r.reader.Execute(ctx, &usecase.ReadInput{
+		StartDate: today.AddDays(1 - recentDays).String(),
+		EndDate:   today.String(),
+	})
+	if err != nil {
+		return nil, rpcErrorOf(err)
+	}
+
+	counts := make(map[string]int)
+	var dates []string
+	for _, n := range output.Nippous {
+		if counts[n.Date] == 0 {
+			dates = append(dates, n.Date)
+		}
+		counts[n.Date]++
+	}
+	resources := make([]Resource, 0, len(dates)+2)
+	for i := len(dates) - 1; i >= 0; i-- {
+		resources = append(resources, Resource{
+			URI:         DateURIPrefix + dates[i],
+			Name:        "Nippou " + dates[i],
+			Description: fmt.Sprintf("%d report(s)", counts[dates[i]]),
+			MIMEType:    MIMEMarkdown,
+		})
+	}
+	for _, week := range []domain.CivilDate{today, today.AddDays(-7)} {
+		resources = append(resources, Resource{
+			URI:      WeekURIPrefix + week.ISOWeekString(),
+			Name:     "Nippou " + week.ISOWeekString(),
+			MIMEType: MIMEMarkdown,
+		})
+	}
+	return map[string]interface{}{"resources": resources}, nil
+}
+
+// templates returns the resource URI templates.
+func (r *Resources) templates(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	return map[string]interface{}{"resourceTemplates": resourceTemplates}, nil
+}
+
+// read renders the resource at params.uri as Markdown and JSON.
+func (r *Resources) read(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		URI string `json:"uri"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	input, err := parseResourceURI(p.URI)
+	if err != nil {
+		return nil, err
+	}
+	output, err := r.reader.Execute(ctx, input)
+	if err != nil {
+		return nil, rpcErrorOf(err)
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"contents": []ResourceContents{
+		{URI: p.URI, MIMEType: MIMEMarkdown, Text: renderMarkdown(input, output)},
+		{URI: p.URI, MIMEType: MIMEJSON, Text: string(data)},
+	}}, nil
+}
+
+// parseResourceURI maps a resource URI to the read it stands for.
+func parseResourceURI(uri string) (*usecase.ReadInput, error) {
+	var input usecase.ReadInput
+	switch {
+	case strings.HasPrefix(uri, DateURIPrefix):
+		input.Date = strings.TrimPrefix(uri, DateURIPrefix)
+	case strings.HasPrefix(uri, IDURIPrefix):
+		input.ID = strings.TrimPrefix(uri, IDURIPrefix)
+	case strings.HasPrefix(uri, WeekURIPrefix):
+		input.Week = strings.TrimPrefix(uri, WeekURIPrefix)
+	}
+	if input == (usecase.ReadInput{}) {
+		return nil, &RPCError{Code: CodeResourceNotFound, Message: "unknown resource", Data: map[string]string{"uri": uri}}
+	}
+	return &input, nil
+}
+
+// ============================================================================
+// Markdown Rendering
+// ============================================================================
+
+// renderMarkdown renders the reports of output with a heading for the read.
+func renderMarkdown(input *usecase.ReadInput, output *usecase.ReadOutput) string {
+	var b strings.Builder
+	switch {
+	case input.Week != "":
+		fmt.Fprintf(&b, "# Nippou %s (%s – %s)\n", input.Week, output.StartDate, output.EndDate)
+	case input.ID != "":
+		fmt.Fprintf(&b, "# Nippou %s\n", input.ID)
+	default:
+		fmt.Fprintf(&b, "# Nippou %s\n", output.StartDate)
+	}
+	if len(output.Nippous) == 0 {
+		b.WriteString("\n_No reports._\n")
+		return b.String()
+	}
+	for _, n := range output.Nippous {
+		writeReport(&b, n)
+	}
+	return b.String()
+}
+
+// writeReport renders one report as a "##" section.
+func writeReport(b *strings.Builder, n *usecase.CreateOutput) {
+	fmt.Fprintf(b, "\n## %s\n\n", n.Date)
+	if len(n.Tags) > 0 {
+		b.WriteString("- Tags: ")
+		for i, tag := range n.Tags {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(b, "`%s`", tag)
+		}
+		b.WriteString("\n")
+	}
+	if loc := n.Location; loc != nil {
+		if loc.Address != "" {
+			fmt.Fprintf(b, "- Location: %s (%.6f, %.6f)\n", loc.Address, loc.Latitude, loc.Longitude)
+		} else {
+			fmt.Fprintf(b, "- Location: %.6f, %.6f\n", loc.Latitude, loc.Longitude)
+		}
+	}
+	fmt.Fprintf(b, "- ID: %s\n\n%s\n", n.ID, strings.TrimRight(n.Content, "\n"))
+}
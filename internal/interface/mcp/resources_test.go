@@ -0,0 +1,218 @@
+package mcp
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+	usecase "salesforce-mcp-server/internal/usecase/nippou"
+)
+
+// memoryRepository is an in-memory domain.Reader and domain.DateRangeReader.
+type memoryRepository struct {
+	nippous []*domain.Nippou
+}
+
+func (m *memoryRepository) FindByID(id domain.ID) (*domain.Nippou, error) {
+	for _, n := range m.nippous {
+		if n.ID().Equals(id) {
+			return n, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memoryRepository) FindByDate(date domain.CivilDate) ([]*domain.Nippou, error) {
+	return m.FindByDateRange(date, date)
+}
+
+func (m *memoryRepository) FindByDateRange(start, end domain.CivilDate) ([]*domain.Nippou, error) {
+	var result []*domain.Nippou
+	for _, n := range m.nippous {
+		if !n.Date().Before(start) && !n.Date().After(end) {
+			result = append(result, n)
+		}
+	}
+	return result, nil
+}
+
+// testNow is the fixed clock of the tests: 2024-01-17, ISO week 2024-W03.
+func testNow() time.Time { return time.Date(2024, 1, 17, 12, 0, 0, 0, time.UTC) }
+
+// newTestReader returns a ReadUseCase over three stored reports.
+func newTestReader(t *testing.T) (*usecase.ReadUseCase, []*domain.Nippou) {
+	t.Helper()
+	repo := &memoryRepository{}
+	for _, r := range []struct{ date, content string }{
+		{"2024-01-08", "先週の訪問"},
+		{"2024-01-15", "A社訪問\n見積提出"},
+		{"2024-01-17", "B社打合せ"},
+	} {
+		n, err := domain.NewNippou(r.date, r.content)
+		if err != nil {
+			t.Fatalf("NewNippou() error = %v", err)
+		}
+		repo.nippous = append(repo.nippous, n)
+	}
+	if err := repo.nippous[1].AddTag("sales"); err != nil {
+		t.Fatalf("AddTag() error = %v", err)
+	}
+
+	reader, err := usecase.NewReadUseCase(repo, repo)
+	if err != nil {
+		t.Fatalf("NewReadUseCase() error = %v", err)
+	}
+	return reader, repo.nippous
+}
+
+// newResourceServer returns a server with the resources of newTestReader.
+func newResourceServer(t *testing.T) (*Server, []*domain.Nippou) {
+	t.Helper()
+	reader, nippous := newTestReader(t)
+	s := NewServer("test", "0.0.0")
+	NewResources(reader).WithLocation(time.UTC).WithTimeFunc(testNow).Register(s)
+	return s, nippous
+}
+
+// readResource calls resources/read and returns the contents by MIME type.
+func readResource(t *testing.T, s *Server, uri string) (map[string]string, *RPCError) {
+	t.Helper()
+	result, rpcErr := call(t, s, "resources/read", map[string]string{"uri": uri})
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	var read struct{ Contents []ResourceContents }
+	if err := json.Unmarshal(result, &read); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	contents := make(map[string]string)
+	for _, c := range read.Contents {
+		if c.URI != uri {
+			t.Errorf("contents uri = %q, want %q", c.URI, uri)
+		}
+		contents[c.MIMEType] = c.Text
+	}
+	return contents, nil
+}
+
+// ============================================================================
+// Resources Tests
+// ============================================================================
+
+func TestResources_List(t *testing.T) {
+	s, _ := newResourceServer(t)
+
+	result, rpcErr := call(t, s, "resources/list", struct{}{})
+	if rpcErr != nil {
+		t.Fatalf("resources/list error = %v", rpcErr)
+	}
+	var list struct{ Resources []Resource }
+	if err := json.Unmarshal(result, &list); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	var uris []string
+	for _, r := range list.Resources {
+		uris = append(uris, r.URI)
+	}
+	want := []string{"nippou://date/2024-01-17", "nippou://date/2024-01-15", "nippou://week/2024-W03", "nippou://week/2024-W02"}
+	if strings.Join(uris, " ") != strings.Join(want, " ") {
+		t.Errorf("uris = %v, want %v", uris, want)
+	}
+}
+
+func TestResources_TemplatesList(t *testing.T) {
+	s, _ := newResourceServer(t)
+
+	result, rpcErr := call(t, s, "resources/templates/list", nil)
+	if rpcErr != nil {
+		t.Fatalf("resources/templates/list error = %v", rpcErr)
+	}
+	var list struct{ ResourceTemplates []ResourceTemplate }
+	if err := json.Unmarshal(result, &list); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(list.ResourceTemplates) != 3 || list.ResourceTemplates[0].URITemplate != "nippou://date/{date}" {
+		t.Errorf("templates = %+v", list.ResourceTemplates)
+	}
+}
+
+func TestResources_ReadByDateAndID(t *testing.T) {
+	s, nippous := newResourceServer(t)
+
+	byDate, rpcErr := readResource(t, s, "nippou://date/2024-01-15")
+	if rpcErr != nil {
+		t.Fatalf("read by date error = %v", rpcErr)
+	}
+	md := byDate[MIMEMarkdown]
+	if !strings.HasPrefix(md, "# Nippou 2024-01-15\n") || !strings.Contains(md, "- Tags: `sales`") ||
+		!strings.Contains(md, "A社訪問\n見積提出") {
+		t.Errorf("markdown = %q", md)
+	}
+	var output usecase.ReadOutput
+	if err := json.Unmarshal([]byte(byDate[MIMEJSON]), &output); err != nil {
+		t.Fatalf("json content: %v", err)
+	}
+	if len(output.Nippous) != 1 || output.Nippous[0].ID != nippous[1].ID().String() {
+		t.Errorf("json output = %+v", output)
+	}
+
+	byID, rpcErr := readResource(t, s, "nippou://id/"+nippous[2].ID().String())
+	if rpcErr != nil {
+		t.Fatalf("read by id error = %v", rpcErr)
+	}
+	if !strings.Contains(byID[MIMEMarkdown], "B社打合せ") {
+		t.Errorf("markdown = %q", byID[MIMEMarkdown])
+	}
+}
+
+func TestResources_ReadWeek(t *testing.T) {
+	s, _ := newResourceServer(t)
+
+	contents, rpcErr := readResource(t, s, "nippou://week/2024-W03")
+	if rpcErr != nil {
+		t.Fatalf("read week error = %v", rpcErr)
+	}
+	md := contents[MIMEMarkdown]
+	if !strings.HasPrefix(md, "# Nippou 2024-W03 (2024-01-15 – 2024-01-21)") ||
+		strings.Index(md, "## 2024-01-15") > strings.Index(md, "## 2024-01-17") || strings.Contains(md, "先週") {
+		t.Errorf("markdown = %q", md)
+	}
+
+	empty, _ := readResource(t, s, "nippou://week/2023-W40")
+	if !strings.Contains(empty[MIMEMarkdown], "_No reports._") {
+		t.Errorf("empty week markdown = %q", empty[MIMEMarkdown])
+	}
+}
+
+func TestResources_ReadErrors(t *testing.T) {
+	s, _ := newResourceServer(t)
+	tests := []struct {
+		name string
+		uri  string
+		code int
+	}{
+		{"unknown scheme", "file:///etc/passwd", CodeResourceNotFound},
+		{"missing id", "nippou://id/" + domain.NewID().String(), CodeResourceNotFound},
+		{"bad date", "nippou://date/2024-13-01", CodeInvalidParams},
+		{"bad week", "nippou://week/2024-W99", CodeInvalidParams},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, rpcErr := readResource(t, s, tt.uri); rpcErr == nil || rpcErr.Code != tt.code {
+				t.Errorf("error = %v, want code %d", rpcErr, tt.code)
+			}
+		})
+	}
+}
+
+func TestResources_ErrorCarriesPayload(t *testing.T) {
+	s, _ := newResourceServer(t)
+
+	_, rpcErr := readResource(t, s, "nippou://date/2024-13-01")
+	data, _ := rpcErr.Data.(map[string]interface{})
+	if data["code"] != "INVALID_INPUT" || data["field"] != "date" || data["retryable"] != false || rpcErr.Message != "Date is invalid." {
+		t.Errorf("error = %+v, data = %v", rpcErr, data)
+	}
+}
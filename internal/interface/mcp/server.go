@@ -0,0 +1,309 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+)
+
+// ============================================================================
+// Server - JSON-RPC Method Dispatch
+// ============================================================================
+
+// HandlerFunc handles one method call. Returning an *RPCError sends it to
+// the client as is; any other error becomes an internal error.
+type HandlerFunc func(ctx context.Context, params json.RawMessage) (interface{}, error)
+
+// ServerInfo identifies the server in the initialize result.
+type ServerInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Server routes MCP requests to registered handlers. Feature providers such
+// as Resources register their methods and capabilities on it.
+type Server struct {
+	info         ServerInfo
+	mu           sync.RWMutex
+	handlers     map[string]HandlerFunc
+	capabilities map[string]interface{}
+
+	inflightMu sync.Mutex
+	inflight   map[string]*inflightRequest // Running requests by canonical ID
+
+	writeMu sync.Mutex
+	out     io.Writer // Set by Serve; notifications are dropped while nil
+}
+
+// inflightRequest is a running request that notifications/cancelled can stop.
+type inflightRequest struct {
+	cancel    context.CancelFunc
+	cancelled bool
+}
+
+// NewServer creates a server answering initialize and ping and honoring
+// notifications/cancelled.
+func NewServer(name, version string) *Server {
+	s := &Server{
+		info:         ServerInfo{Name: name, Version: version},
+		handlers:     make(map[string]HandlerFunc),
+		capabilities: make(map[string]interface{}),
+		inflight:     make(map[string]*inflightRequest),
+	}
+	s.Register("initialize", s.initialize)
+	s.Register("ping", func(ctx context.Context, params json.RawMessage) (interface{}, error) {
+		return struct{}{}, nil
+	})
+	s.Register("notifications/initialized", func(ctx context.Context, params json.RawMessage) (interface{}, error) {
+		return nil, nil
+	})
+	s.Register("notifications/cancelled", s.cancelled)
+	return s
+}
+
+// Register installs handler for method, replacing any previous handler.
+func (s *Server) Register(method string, handler HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method] = handler
+}
+
+// SetCapability advertises a capability in the initialize result.
+func (s *Server) SetCapability(name string, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.capabilities[name] = value
+}
+
+// initialize answers the client handshake.
+func (s *Server) initialize(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	capabilities := make(map[string]interface{}, len(s.capabilities))
+	for name, value := range s.capabilities {
+		capabilities[name] = value
+	}
+	return map[string]interface{}{
+		"protocolVersion": ProtocolVersion,
+		"serverInfo":      s.info,
+		"capabilities":    capabilities,
+	}, nil
+}
+
+// Handle dispatches req and returns its response, or nil for notifications.
+func (s *Server) Handle(ctx context.Context, req *Request) *Response {
+	return s.start(ctx, req)()
+}
+
+// start registers req as running, when it is a request, and returns the
+// function that handles it. Serve calls start before handing the request to
+// a goroutine, so a notifications/cancelled read after it always finds it.
+func (s *Server) start(ctx context.Context, req *Request) func() *Response {
+	if req.JSONRPC != JSONRPCVersion || req.Method == "" {
+		return respond(errorResponse(req.ID, NewRPCError(CodeInvalidRequest, "invalid JSON-RPC request")))
+	}
+
+	s.mu.RLock()
+	handler, ok := s.handlers[req.Method]
+	s.mu.RUnlock()
+	if !ok {
+		if req.IsNotification() {
+			return respond(nil)
+		}
+		return respond(errorResponse(req.ID, NewRPCError(CodeMethodNotFound, "method not found: %s", req.Method)))
+	}
+
+	if req.IsNotification() {
+		return func() *Response {
+			_, _ = handler(ctx, req.Params)
+			return nil
+		}
+	}
+
+	ctx, done := s.track(ctx, req.ID)
+	return func() *Response {
+		result, err := handler(withProgress(ctx, s, req.Params), req.Params)
+		if cancelled := done(); cancelled {
+			// The client has given up on the request and expects no response
+			return nil
+		}
+		if err != nil {
+			var rpcErr *RPCError
+			if !errors.As(err, &rpcErr) {
+				rpcErr = NewRPCError(CodeInternalError, "%v", err)
+			}
+			return errorResponse(req.ID, rpcErr)
+		}
+		if result == nil {
+			result = struct{}{}
+		}
+		return &Response{JSONRPC: JSONRPCVersion, ID: req.ID, Result: result}
+	}
+}
+
+// respond returns a handler function with a fixed response.
+func respond(resp *Response) func() *Response {
+	return func() *Response { return resp }
+}
+
+// HandleMessage decodes one JSON-RPC message, dispatches it and encodes the
+// response. It returns nil when there is nothing to send back.
+func (s *Server) HandleMessage(ctx context.Context, message []byte) []byte {
+	return s.startMessage(ctx, message)()
+}
+
+// startMessage decodes message and starts it like start; the returned
+// function encodes the response.
+func (s *Server) startMessage(ctx context.Context, message []byte) func() []byte {
+	var req Request
+	run := respond(nil)
+	if err := json.Unmarshal(message, &req); err != nil {
+		run = respond(errorResponse(nil, NewRPCError(CodeParseError, "parse error: %v", err)))
+	} else {
+		run = s.start(ctx, &req)
+	}
+	return func() []byte {
+		resp := run()
+		if resp == nil {
+			return nil
+		}
+		data, err := json.Marshal(resp)
+		if err != nil {
+			data, _ = json.Marshal(errorResponse(req.ID, NewRPCError(CodeInternalError, "encode response: %v", err)))
+		}
+		return data
+	}
+}
+
+// Serve reads newline-delimited JSON-RPC messages from in and writes
+// responses and notifications to out, as in the MCP stdio transport.
+// Requests run concurrently so that a slow request can be cancelled. Serve
+// returns when in is exhausted and every running request has finished.
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	s.writeMu.Lock()
+	s.out = out
+	s.writeMu.Unlock()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		// Register the request before it runs, so that a cancellation on a
+		// later line cannot overtake it
+		run := s.startMessage(ctx, append([]byte(nil), line...))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if resp := run(); resp != nil {
+				_ = s.writeLine(resp)
+			}
+		}()
+	}
+	return scanner.Err()
+}
+
+// Notify sends a notification to the client. It is a no-op before Serve.
+func (s *Server) Notify(method string, params interface{}) error {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(Request{JSONRPC: JSONRPCVersion, Method: method, Params: raw})
+	if err != nil {
+		return err
+	}
+	return s.writeLine(data)
+}
+
+// writeLine writes one message followed by a newline.
+func (s *Server) writeLine(data []byte) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if s.out == nil {
+		return nil
+	}
+	_, err := s.out.Write(append(data, '\n'))
+	return err
+}
+
+// ============================================================================
+// Cancellation
+// ============================================================================
+
+// track registers a running request and returns its cancellable context and
+// a function that unregisters it and reports whether it was cancelled.
+func (s *Server) track(ctx context.Context, id json.RawMessage) (context.Context, func() bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	key := canonicalID(id)
+	entry := &inflightRequest{cancel: cancel}
+
+	s.inflightMu.Lock()
+	s.inflight[key] = entry
+	s.inflightMu.Unlock()
+
+	return ctx, func() bool {
+		s.inflightMu.Lock()
+		defer s.inflightMu.Unlock()
+		if s.inflight[key] == entry {
+			delete(s.inflight, key)
+		}
+		cancel()
+		return entry.cancelled
+	}
+}
+
+// cancelled handles notifications/cancelled by cancelling the request's ctx.
+// Unknown or finished requests are ignored.
+func (s *Server) cancelled(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		RequestID json.RawMessage `json:"requestId"`
+		Reason    string          `json:"reason,omitempty"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	s.inflightMu.Lock()
+	defer s.inflightMu.Unlock()
+	if entry, ok := s.inflight[canonicalID(p.RequestID)]; ok {
+		entry.cancelled = true
+		entry.cancel()
+	}
+	return nil, nil
+}
+
+// canonicalID returns a map key for a request ID regardless of whitespace.
+func canonicalID(id json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, id); err != nil {
+		return string(id)
+	}
+	return buf.String()
+}
+
+// errorResponse builds a response carrying err.
+func errorResponse(id json.RawMessage, err *RPCError) *Response {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &Response{JSONRPC: JSONRPCVersion, ID: id, Error: err}
+}
+
+// decodeParams unmarshals params into v, reporting failures as invalid params.
+func decodeParams(params json.RawMessage, v interface{}) error {
+	if len(params) == 0 {
+		return NewRPCError(CodeInvalidParams, "params are required")
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return NewRPCError(CodeInvalidParams, "invalid params: %v", err)
+	}
+	return nil
+}
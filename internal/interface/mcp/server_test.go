@@ -0,0 +1,91 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// call sends a request through HandleMessage and decodes the response.
+func call(t *testing.T, s *Server, method string, params interface{}) (json.RawMessage, *RPCError) {
+	t.Helper()
+	raw, err := json.Marshal(params)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	msg, _ := json.Marshal(Request{JSONRPC: JSONRPCVersion, ID: json.RawMessage("1"), Method: method, Params: raw})
+	out := s.HandleMessage(context.Background(), msg)
+	var resp struct {
+		ID     json.RawMessage `json:"id"`
+		Result json.RawMessage `json:"result"`
+		Error  *RPCError       `json:"error"`
+	}
+	if err := json.Unmarshal(out, &resp); err != nil {
+		t.Fatalf("response %s is not JSON: %v", out, err)
+	}
+	if string(resp.ID) != "1" {
+		t.Errorf("response id = %s, want 1", resp.ID)
+	}
+	return resp.Result, resp.Error
+}
+
+func TestServer_Initialize(t *testing.T) {
+	s := NewServer("salesforce-mcp-server", "6.1.0")
+	s.SetCapability("resources", map[string]interface{}{})
+
+	result, rpcErr := call(t, s, "initialize", map[string]interface{}{"protocolVersion": ProtocolVersion})
+	if rpcErr != nil {
+		t.Fatalf("initialize error = %v", rpcErr)
+	}
+	var init struct {
+		ProtocolVersion string                     `json:"protocolVersion"`
+		ServerInfo      ServerInfo                 `json:"serverInfo"`
+		Capabilities    map[string]json.RawMessage `json:"capabilities"`
+	}
+	if err := json.Unmarshal(result, &init); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if init.ProtocolVersion != ProtocolVersion || init.ServerInfo.Name != "salesforce-mcp-server" {
+		t.Errorf("initialize = %+v", init)
+	}
+	if _, ok := init.Capabilities["resources"]; !ok {
+		t.Errorf("capabilities = %v, want resources", init.Capabilities)
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	s := NewServer("test", "0.0.0")
+	s.Register("fail", func(ctx context.Context, params json.RawMessage) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+
+	tests := []struct {
+		name    string
+		message string
+		code    int
+	}{
+		{"parse error", `{"jsonrpc":`, CodeParseError},
+		{"wrong version", `{"jsonrpc":"1.0","id":1,"method":"ping"}`, CodeInvalidRequest},
+		{"unknown method", `{"jsonrpc":"2.0","id":1,"method":"nope"}`, CodeMethodNotFound},
+		{"handler error", `{"jsonrpc":"2.0","id":1,"method":"fail"}`, CodeInternalError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp Response
+			if err := json.Unmarshal(s.HandleMessage(context.Background(), []byte(tt.message)), &resp); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if resp.Error == nil || resp.Error.Code != tt.code {
+				t.Errorf("error = %+v, want code %d", resp.Error, tt.code)
+			}
+		})
+	}
+}
+
+func TestServer_NotificationHasNoResponse(t *testing.T) {
+	s := NewServer("test", "0.0.0")
+	if out := s.HandleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","method":"notifications/initialized"}`)); out != nil {
+		t.Errorf("HandleMessage() = %s, want nil", out)
+	}
+}
@@ -0,0 +1,201 @@
+package nippou
+
+import (
+	"context"
+	"time"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Comment UseCases - Manager Feedback Thread
+// ============================================================================
+
+// AddCommentUseCase posts a comment on an existing Nippou.
+type AddCommentUseCase struct {
+	nippous  domain.Reader
+	comments domain.CommentRepository
+}
+
+// NewAddCommentUseCase creates a new AddCommentUseCase. nippous is used to
+// check that the commented Nippou exists.
+func NewAddCommentUseCase(nippous domain.Reader, comments domain.CommentRepository) (*AddCommentUseCase, error) {
+	if nippous == nil || comments == nil {
+		return nil, ErrRepositoryNil
+	}
+	return &AddCommentUseCase{nippous: nippous, comments: comments}, nil
+}
+
+// Execute validates and persists the comment.
+func (uc *AddCommentUseCase) Execute(ctx context.Context, input *AddCommentInput) (*CommentOutput, error) {
+	// Guard: Check context
+	if ctx == nil {
+		return nil, ErrContextNil
+	}
+
+	// Step 1: Validate input DTO
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	nippouID, err := domain.IDFromString(input.NippouID)
+	if err != nil {
+		return nil, NewDomainViolationError(err)
+	}
+
+	// Step 2: Build the comment entity
+	comment, err := domain.NewComment(nippouID, input.Author, input.Body)
+	if err != nil {
+		return nil, NewDomainViolationError(err)
+	}
+
+	// Check for context cancellation before repository operations
+	select {
+	case <-ctx.Done():
+		return nil, &UseCaseError{
+			Code:    ErrCodeContextCancelled,
+			Message: "operation cancelled",
+			Cause:   ctx.Err(),
+		}
+	default:
+	}
+
+	// Step 3: Make sure the Nippou exists
+	n, err := uc.nippous.FindByID(nippouID)
+	if err != nil {
+		return nil, NewRepositoryReadError(err)
+	}
+	if n == nil {
+		return nil, NewNotFoundError("nippou", nippouID.String())
+	}
+
+	// Step 4: Persist
+	if err := uc.comments.SaveComment(comment); err != nil {
+		return nil, NewCommentRepositoryError("save", err)
+	}
+
+	output := mapCommentToOutput(comment)
+	return &output, nil
+}
+
+// ListCommentsUseCase lists the comments on a Nippou, oldest first.
+type ListCommentsUseCase struct {
+	comments domain.CommentRepository
+}
+
+// NewListCommentsUseCase creates a new ListCommentsUseCase.
+func NewListCommentsUseCase(comments domain.CommentRepository) (*ListCommentsUseCase, error) {
+	if comments == nil {
+		return nil, ErrRepositoryNil
+	}
+	return &ListCommentsUseCase{comments: comments}, nil
+}
+
+// Execute loads the comment thread.
+func (uc *ListCommentsUseCase) Execute(ctx context.Context, input *ListCommentsInput) (*ListCommentsOutput, error) {
+	// Guard: Check context
+	if ctx == nil {
+		return nil, ErrContextNil
+	}
+
+	// Step 1: Validate input DTO
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	nippouID, err := domain.IDFromString(input.NippouID)
+	if err != nil {
+		return nil, NewDomainViolationError(err)
+	}
+
+	// Check for context cancellation before repository operation
+	select {
+	case <-ctx.Done():
+		return nil, &UseCaseError{
+			Code:    ErrCodeContextCancelled,
+			Message: "operation cancelled",
+			Cause:   ctx.Err(),
+		}
+	default:
+	}
+
+	// Step 2: Load comments
+	comments, err := uc.comments.FindByNippouID(nippouID)
+	if err != nil {
+		return nil, NewCommentRepositoryError("load", err)
+	}
+
+	output := &ListCommentsOutput{NippouID: nippouID.String(), Comments: make([]CommentOutput, 0, len(comments))}
+	for _, c := range comments {
+		if c != nil {
+			output.Comments = append(output.Comments, mapCommentToOutput(c))
+		}
+	}
+	return output, nil
+}
+
+// DeleteCommentUseCase removes a comment.
+type DeleteCommentUseCase struct {
+	comments domain.CommentRepository
+}
+
+// NewDeleteCommentUseCase creates a new DeleteCommentUseCase.
+func NewDeleteCommentUseCase(comments domain.CommentRepository) (*DeleteCommentUseCase, error) {
+	if comments == nil {
+		return nil, ErrRepositoryNil
+	}
+	return &DeleteCommentUseCase{comments: comments}, nil
+}
+
+// Execute deletes the comment. Deleting a comment that does not exist
+// returns a NOT_FOUND error so callers can tell a typo from success.
+func (uc *DeleteCommentUseCase) Execute(ctx context.Context, input *DeleteCommentInput) error {
+	// Guard: Check context
+	if ctx == nil {
+		return ErrContextNil
+	}
+
+	// Step 1: Validate input DTO
+	if err := input.Validate(); err != nil {
+		return err
+	}
+	id, err := domain.IDFromString(input.CommentID)
+	if err != nil {
+		return NewDomainViolationError(err)
+	}
+
+	// Check for context cancellation before repository operations
+	select {
+	case <-ctx.Done():
+		return &UseCaseError{
+			Code:    ErrCodeContextCancelled,
+			Message: "operation cancelled",
+			Cause:   ctx.Err(),
+		}
+	default:
+	}
+
+	// Step 2: Make sure the comment exists
+	comment, err := uc.comments.FindCommentByID(id)
+	if err != nil {
+		return NewCommentRepositoryError("load", err)
+	}
+	if comment == nil {
+		return NewNotFoundError("comment", id.String())
+	}
+
+	// Step 3: Delete
+	if err := uc.comments.DeleteComment(id); err != nil {
+		return NewCommentRepositoryError("delete", err)
+	}
+	return nil
+}
+
+// mapCommentToOutput converts a domain Comment to the output DTO.
+func mapCommentToOutput(c *domain.Comment) CommentOutput {
+	return CommentOutput{
+		ID:        c.ID().String(),
+		NippouID:  c.NippouID().String(),
+		Author:    c.Author(),
+		Body:      c.Body(),
+		CreatedAt: c.CreatedAt().UTC().Format(time.RFC3339),
+	}
+}
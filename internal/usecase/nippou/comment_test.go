@@ -0,0 +1,188 @@
+package nippou
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Test Doubles
+// ============================================================================
+
+// MockCommentRepository is an in-memory test double for domain.CommentRepository.
+type MockCommentRepository struct {
+	Comments   []*domain.Comment
+	SaveErr    error
+	DeleteErr  error
+	DeletedIDs []domain.ID
+}
+
+func (m *MockCommentRepository) FindCommentByID(id domain.ID) (*domain.Comment, error) {
+	for _, c := range m.Comments {
+		if c.ID().Equals(id) {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockCommentRepository) FindByNippouID(nippouID domain.ID) ([]*domain.Comment, error) {
+	var result []*domain.Comment
+	for _, c := range m.Comments {
+		if c.NippouID().Equals(nippouID) {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockCommentRepository) SaveComment(c *domain.Comment) error {
+	if m.SaveErr != nil {
+		return m.SaveErr
+	}
+	m.Comments = append(m.Comments, c)
+	return nil
+}
+
+func (m *MockCommentRepository) DeleteComment(id domain.ID) error {
+	if m.DeleteErr != nil {
+		return m.DeleteErr
+	}
+	m.DeletedIDs = append(m.DeletedIDs, id)
+	return nil
+}
+
+// existingNippouRepo returns a repository in which every ID exists.
+func existingNippouRepo(t *testing.T) *MockRepository {
+	t.Helper()
+	n, err := domain.NewNippou("2024-01-15", "report")
+	if err != nil {
+		t.Fatalf("NewNippou() error = %v", err)
+	}
+	return &MockRepository{FindByIDFunc: func(id domain.ID) (*domain.Nippou, error) { return n, nil }}
+}
+
+// ============================================================================
+// Comment UseCase Tests
+// ============================================================================
+
+func TestCommentUseCases_Thread(t *testing.T) {
+	comments := &MockCommentRepository{}
+	add, _ := NewAddCommentUseCase(existingNippouRepo(t), comments)
+	list, _ := NewListCommentsUseCase(comments)
+	del, _ := NewDeleteCommentUseCase(comments)
+	nippouID := domain.NewID().String()
+
+	first, err := add.Execute(context.Background(), &AddCommentInput{NippouID: nippouID, Author: "佐藤", Body: " 見積を添付してください\r\n"})
+	if err != nil {
+		t.Fatalf("AddComment error = %v", err)
+	}
+	if first.Body != "見積を添付してください" || first.NippouID != nippouID || first.CreatedAt == "" {
+		t.Errorf("unexpected output: %+v", first)
+	}
+	if _, err := add.Execute(context.Background(), &AddCommentInput{NippouID: nippouID, Author: "田中", Body: "了解です"}); err != nil {
+		t.Fatalf("AddComment error = %v", err)
+	}
+
+	thread, err := list.Execute(context.Background(), &ListCommentsInput{NippouID: nippouID})
+	if err != nil {
+		t.Fatalf("ListComments error = %v", err)
+	}
+	if len(thread.Comments) != 2 || thread.Comments[0].Author != "佐藤" || thread.Comments[1].Author != "田中" {
+		t.Errorf("unexpected thread: %+v", thread.Comments)
+	}
+
+	if err := del.Execute(context.Background(), &DeleteCommentInput{CommentID: first.ID}); err != nil {
+		t.Fatalf("DeleteComment error = %v", err)
+	}
+	if len(comments.DeletedIDs) != 1 || comments.DeletedIDs[0].String() != first.ID {
+		t.Errorf("DeletedIDs = %v", comments.DeletedIDs)
+	}
+}
+
+func TestListCommentsUseCase_EmptyThread(t *testing.T) {
+	list, _ := NewListCommentsUseCase(&MockCommentRepository{})
+	output, err := list.Execute(context.Background(), &ListCommentsInput{NippouID: domain.NewID().String()})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.Comments == nil || len(output.Comments) != 0 {
+		t.Errorf("Comments = %v, want empty slice", output.Comments)
+	}
+}
+
+func TestCommentUseCases_Errors(t *testing.T) {
+	missing := &MockRepository{}
+	failing := &MockCommentRepository{SaveErr: errors.New("timeout")}
+	addMissing, _ := NewAddCommentUseCase(missing, &MockCommentRepository{})
+	addFailing, _ := NewAddCommentUseCase(existingNippouRepo(t), failing)
+	del, _ := NewDeleteCommentUseCase(&MockCommentRepository{})
+	nippouID := domain.NewID().String()
+
+	tests := []struct {
+		name string
+		call func() error
+		code string
+	}{
+		{"nil input", func() error { _, err := addMissing.Execute(context.Background(), nil); return err }, ErrCodeInvalidInput},
+		{"empty body", func() error {
+			_, err := addMissing.Execute(context.Background(), &AddCommentInput{NippouID: nippouID, Author: "a", Body: " "})
+			return err
+		}, ErrCodeInvalidInput},
+		{"invalid nippou ID", func() error {
+			_, err := addMissing.Execute(context.Background(), &AddCommentInput{NippouID: "x", Author: "a", Body: "b"})
+			return err
+		}, ErrCodeDomainViolation},
+		{"unknown nippou", func() error {
+			_, err := addMissing.Execute(context.Background(), &AddCommentInput{NippouID: nippouID, Author: "a", Body: "b"})
+			return err
+		}, ErrCodeNotFound},
+		{"save failure", func() error {
+			_, err := addFailing.Execute(context.Background(), &AddCommentInput{NippouID: nippouID, Author: "a", Body: "b"})
+			return err
+		}, ErrCodeRepositoryError},
+		{"delete unknown comment", func() error {
+			return del.Execute(context.Background(), &DeleteCommentInput{CommentID: domain.NewID().String()})
+		}, ErrCodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			var ucErr *UseCaseError
+			if !errors.As(err, &ucErr) || ucErr.Code != tt.code {
+				t.Errorf("error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestCommentUseCases_RepositoryErrorsNameTheOperation(t *testing.T) {
+	stored, _ := domain.NewComment(domain.NewID(), "a", "b")
+	add, _ := NewAddCommentUseCase(existingNippouRepo(t), &MockCommentRepository{SaveErr: errors.New("timeout")})
+	del, _ := NewDeleteCommentUseCase(&MockCommentRepository{Comments: []*domain.Comment{stored}, DeleteErr: errors.New("timeout")})
+
+	_, err := add.Execute(context.Background(), &AddCommentInput{NippouID: domain.NewID().String(), Author: "a", Body: "b"})
+	var ucErr *UseCaseError
+	if !errors.As(err, &ucErr) || ucErr.Code != ErrCodeRepositoryError || ucErr.Message != "failed to save comment" {
+		t.Errorf("AddComment error = %v, want failed to save comment", err)
+	}
+	err = del.Execute(context.Background(), &DeleteCommentInput{CommentID: stored.ID().String()})
+	if !errors.As(err, &ucErr) || ucErr.Code != ErrCodeRepositoryError || ucErr.Message != "failed to delete comment" {
+		t.Errorf("DeleteComment error = %v, want failed to delete comment", err)
+	}
+}
+
+func TestNewCommentUseCases_NilRepository(t *testing.T) {
+	if _, err := NewAddCommentUseCase(&MockRepository{}, nil); err != ErrRepositoryNil {
+		t.Errorf("NewAddCommentUseCase() error = %v, want ErrRepositoryNil", err)
+	}
+	if _, err := NewListCommentsUseCase(nil); err != ErrRepositoryNil {
+		t.Errorf("NewListCommentsUseCase() error = %v, want ErrRepositoryNil", err)
+	}
+	if _, err := NewDeleteCommentUseCase(nil); err != ErrRepositoryNil {
+		t.Errorf("NewDeleteCommentUseCase() error = %v, want ErrRepositoryNil", err)
+	}
+}
@@ -0,0 +1,170 @@
+package nippou
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/width"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Date Resolver - Relative and Natural Date Input
+// ============================================================================
+
+// DateResolver turns the date given by a user into a calendar date. Besides
+// YYYY-MM-DD it understands the relative forms people use when speaking:
+//
+//	today, yesterday, the day before yesterday, 3 days ago,
+//	last friday, this monday, friday,
+//	今日, 昨日, 一昨日, 3日前, 先週金曜, 今週月曜日, 金曜
+//
+// "last X" and 先週X are X of the previous Monday-to-Sunday week, "this X"
+// and 今週X are X of the current week, and a bare weekday is the most recent
+// such day on or before today. Expressions are resolved against the clock and
+// time zone of the resolver; dates after today are rejected unless allowed.
+type DateResolver struct {
+	location    *time.Location
+	timeFunc    func() time.Time
+	allowFuture bool
+}
+
+// NewDateResolver creates a DateResolver for the default user time zone
+// (domain.DefaultTimeZone) that rejects future dates.
+func NewDateResolver() *DateResolver {
+	return &DateResolver{
+		location: domain.DefaultLocation(),
+		timeFunc: time.Now,
+	}
+}
+
+// WithLocation sets the user's time zone, which decides what "today" is.
+func (r *DateResolver) WithLocation(loc *time.Location) *DateResolver {
+	r.location = loc
+	return r
+}
+
+// WithTimeFunc sets a custom time function (useful for testing).
+func (r *DateResolver) WithTimeFunc(fn func() time.Time) *DateResolver {
+	r.timeFunc = fn
+	return r
+}
+
+// AllowFutureDates sets whether dates after today are accepted.
+func (r *DateResolver) AllowFutureDates(allow bool) *DateResolver {
+	r.allowFuture = allow
+	return r
+}
+
+// Resolve converts expr to a calendar date.
+// Returns a DOMAIN_VIOLATION error wrapping domain.ErrInvalidDateFormat when
+// expr is not understood, and ErrFutureDate when the policy rejects the date.
+func (r *DateResolver) Resolve(expr string) (domain.CivilDate, error) {
+	today := domain.TodayIn(r.timeFunc(), r.location)
+
+	date, ok := resolveDateExpression(expr, today)
+	if !ok {
+		return domain.CivilDate{}, NewDomainViolationError(domain.ErrInvalidDateFormat)
+	}
+	if !r.allowFuture && date.After(today) {
+		return domain.CivilDate{}, ErrFutureDate
+	}
+	return date, nil
+}
+
+// ============================================================================
+// Expression Tables
+// ============================================================================
+
+// dayOffsets maps fixed expressions to their offset from today.
+var dayOffsets = map[string]int{
+	"today":                    0,
+	"yesterday":                -1,
+	"day before yesterday":     -2,
+	"the day before yesterday": -2,
+	"tomorrow":                 1,
+	"今日":                       0,
+	"きょう":                      0,
+	"本日":                       0,
+	"昨日":                       -1,
+	"きのう":                      -1,
+	"一昨日":                      -2,
+	"おととい":                     -2,
+	"おとつい":                     -2,
+	"明日":                       1,
+	"あした":                      1,
+}
+
+// englishWeekdays maps lowercase English weekday names.
+var englishWeekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// japaneseWeekdays maps the weekday kanji used before 曜.
+var japaneseWeekdays = map[string]time.Weekday{
+	"日": time.Sunday,
+	"月": time.Monday,
+	"火": time.Tuesday,
+	"水": time.Wednesday,
+	"木": time.Thursday,
+	"金": time.Friday,
+	"土": time.Saturday,
+}
+
+var (
+	daysAgoPattern    = regexp.MustCompile(`^(\d{1,3}) days? ago$|^(\d{1,3})日前$`)
+	enWeekdayPattern  = regexp.MustCompile(`^(?:(last|this) )?([a-z]+)$`)
+	jaWeekdayPattern  = regexp.MustCompile(`^(先週|今週)?の?([日月火水木金土])曜日?$`)
+	weekOffsetByWords = map[string]int{"last": -1, "先週": -1, "this": 0, "今週": 0}
+)
+
+// resolveDateExpression resolves expr relative to today.
+func resolveDateExpression(expr string, today domain.CivilDate) (domain.CivilDate, bool) {
+	s := normalizeDateExpression(expr)
+
+	if date, err := domain.ParseCivilDate(s); err == nil {
+		return date, true
+	}
+	if offset, ok := dayOffsets[s]; ok {
+		return today.AddDays(offset), true
+	}
+	if m := daysAgoPattern.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[1] + m[2])
+		return today.AddDays(-n), true
+	}
+	if m := enWeekdayPattern.FindStringSubmatch(s); m != nil {
+		if wd, ok := englishWeekdays[m[2]]; ok {
+			return weekdayDate(today, m[1], wd), true
+		}
+	}
+	if m := jaWeekdayPattern.FindStringSubmatch(s); m != nil {
+		return weekdayDate(today, m[1], japaneseWeekdays[m[2]]), true
+	}
+	return domain.CivilDate{}, false
+}
+
+// weekdayDate returns wd in the week selected by qualifier ("last", "this",
+// 先週, 今週), or the most recent wd on or before today when qualifier is empty.
+func weekdayDate(today domain.CivilDate, qualifier string, wd time.Weekday) domain.CivilDate {
+	if qualifier == "" {
+		return today.AddDays(-((int(today.Weekday()) - int(wd) + 7) % 7))
+	}
+	monday := today.AddDays(-((int(today.Weekday()) + 6) % 7))
+	return monday.AddDays(7*weekOffsetByWords[qualifier] + (int(wd)+6)%7)
+}
+
+// normalizeDateExpression folds full-width digits and letters (common in
+// Japanese voice transcripts), lowercases and collapses whitespace.
+func normalizeDateExpression(expr string) string {
+	s := strings.ToLower(width.Fold.String(expr))
+	return strings.Join(strings.Fields(s), " ")
+}
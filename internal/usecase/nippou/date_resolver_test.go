@@ -0,0 +1,126 @@
+package nippou
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// DateResolver Tests
+// ============================================================================
+
+// fixedResolver returns a resolver whose "now" is Wednesday 2026-10-14 10:00 JST.
+func fixedResolver() *DateResolver {
+	return NewDateResolver().WithTimeFunc(func() time.Time {
+		return time.Date(2026, 10, 14, 1, 0, 0, 0, time.UTC)
+	})
+}
+
+func TestDateResolver_Resolve(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"2026-10-01", "2026-10-01"},
+		{"today", "2026-10-14"},
+		{"  Yesterday ", "2026-10-13"},
+		{"the day before yesterday", "2026-10-12"},
+		{"3 days ago", "2026-10-11"},
+		{"1 day ago", "2026-10-13"},
+		{"last Friday", "2026-10-09"},
+		{"last monday", "2026-10-05"},
+		{"this Monday", "2026-10-12"},
+		{"friday", "2026-10-09"},
+		{"wednesday", "2026-10-14"},
+		{"今日", "2026-10-14"},
+		{"昨日", "2026-10-13"},
+		{"一昨日", "2026-10-12"},
+		{"おととい", "2026-10-12"},
+		{"３日前", "2026-10-11"},
+		{"先週金曜", "2026-10-09"},
+		{"先週の金曜日", "2026-10-09"},
+		{"今週月曜日", "2026-10-12"},
+		{"日曜", "2026-10-11"},
+	}
+	r := fixedResolver()
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := r.Resolve(tt.expr)
+			if err != nil {
+				t.Fatalf("Resolve(%q) error = %v", tt.expr, err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("Resolve(%q) = %s, want %s", tt.expr, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDateResolver_UsesUserTimeZone(t *testing.T) {
+	// 23:30 JST on 2026-10-15 is still 10-15 in Tokyo, but 14:30 UTC
+	r := NewDateResolver().WithTimeFunc(func() time.Time {
+		return time.Date(2026, 10, 15, 14, 30, 0, 0, time.UTC)
+	})
+	if got, _ := r.Resolve("yesterday"); got.String() != "2026-10-14" {
+		t.Errorf("Resolve(yesterday) = %s, want 2026-10-14", got)
+	}
+
+	// 00:30 JST on 10-16 is already the next day in Tokyo
+	r.WithTimeFunc(func() time.Time { return time.Date(2026, 10, 15, 15, 30, 0, 0, time.UTC) })
+	if got, _ := r.Resolve("今日"); got.String() != "2026-10-16" {
+		t.Errorf("Resolve(今日) = %s, want 2026-10-16", got)
+	}
+	if got, _ := r.WithLocation(time.UTC).Resolve("today"); got.String() != "2026-10-15" {
+		t.Errorf("Resolve(today) in UTC = %s, want 2026-10-15", got)
+	}
+}
+
+func TestDateResolver_FuturePolicy(t *testing.T) {
+	r := fixedResolver()
+	for _, expr := range []string{"tomorrow", "明日", "this friday", "2026-10-15"} {
+		if _, err := r.Resolve(expr); err != ErrFutureDate {
+			t.Errorf("Resolve(%q) error = %v, want ErrFutureDate", expr, err)
+		}
+	}
+
+	got, err := r.AllowFutureDates(true).Resolve("this friday")
+	if err != nil || got.String() != "2026-10-16" {
+		t.Errorf("Resolve(this friday) = %s, %v; want 2026-10-16 when future dates are allowed", got, err)
+	}
+}
+
+func TestDateResolver_Unrecognized(t *testing.T) {
+	r := fixedResolver()
+	for _, expr := range []string{"", "someday", "last week", "2026/10/01", "先週", "next friday", "1000 days ago"} {
+		_, err := r.Resolve(expr)
+		if !IsDomainViolation(err) || !errors.Is(err, domain.ErrInvalidDateFormat) {
+			t.Errorf("Resolve(%q) error = %v, want domain violation for invalid date format", expr, err)
+		}
+	}
+}
+
+func TestExecute_RelativeDate(t *testing.T) {
+	repo := &MockRepository{}
+	uc, _ := NewCreateUseCase(repo)
+	uc.WithDateResolver(fixedResolver())
+
+	output, err := uc.Execute(context.Background(), &CreateInput{Date: "先週金曜", Content: "content"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.Date != "2026-10-09" {
+		t.Errorf("Output.Date = %q, want 2026-10-09", output.Date)
+	}
+
+	_, err = uc.Execute(context.Background(), &CreateInput{Date: "tomorrow", Content: "content"})
+	if err != ErrFutureDate {
+		t.Errorf("Execute(tomorrow) error = %v, want ErrFutureDate", err)
+	}
+	if repo.SaveCalled != 1 {
+		t.Errorf("Save() called %d times, want 1", repo.SaveCalled)
+	}
+}
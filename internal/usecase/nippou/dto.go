@@ -27,68 +27,78 @@ type VoiceInput struct {
 
 // CreateInput is the input DTO for creating a Nippou.
 type CreateInput struct {
-	Date     string
+	Date     string // YYYY-MM-DD or a relative expression (see DateResolver)
 	Content  string
 	Location *LocationInput
 	Voice    *VoiceInput
 	Tags     []string
+	Template string // Optional template name; content must satisfy its sections
 }
 
 // Validate performs early validation on the input DTO.
-// This catches obvious errors before domain processing.
+// This catches obvious errors before domain processing. Date is only checked
+// for presence; relative forms such as "yesterday" are resolved by Execute.
+// All problems are reported together; the returned error wraps a
+// domain.ValidationErrors whose fields are input paths such as
+// "location.latitude" or "tags[2]".
 func (i *CreateInput) Validate() error {
 	if i == nil {
 		return ErrNilInput
 	}
+	var errs domain.ValidationErrors
 
 	// Validate required fields
 	if strings.TrimSpace(i.Date) == "" {
-		return NewInvalidInputError("date", "cannot be empty")
+		errs.Add(errEmptyDate)
 	}
 	if strings.TrimSpace(i.Content) == "" {
-		return NewInvalidInputError("content", "cannot be empty")
+		errs.Add(domain.ErrEmptyContent)
+	} else if utf8.RuneCountInString(i.Content) > domain.MaxContentLength {
+		// Early check to avoid processing large payloads
+		errs.Add(domain.ErrContentTooLong)
 	}
 
-	// Validate content length (early check to avoid processing large payloads)
-	if utf8.RuneCountInString(i.Content) > domain.MaxContentLength {
-		return NewInvalidInputError("content", fmt.Sprintf("exceeds maximum length of %d characters", domain.MaxContentLength))
-	}
-
-	// Validate tags count
+	// Validate tags count and individual tag lengths
 	if len(i.Tags) > domain.MaxTagCount {
-		return NewInvalidInputError("tags", fmt.Sprintf("exceeds maximum count of %d", domain.MaxTagCount))
+		errs.Add(domain.ErrMaxTagsExceeded)
 	}
-
-	// Validate individual tag lengths
 	for idx, tag := range i.Tags {
 		if utf8.RuneCountInString(tag) > domain.MaxTagLength {
-			return NewInvalidInputError("tags", fmt.Sprintf("tag at index %d exceeds maximum length of %d", idx, domain.MaxTagLength))
+			errs.Add(domain.ErrTagTooLong.WithField(fmt.Sprintf("tags[%d]", idx)))
 		}
 	}
 
+	// Validate template name length
+	if utf8.RuneCountInString(i.Template) > domain.MaxTemplateNameLength {
+		errs.Add(domain.ErrTemplateNameTooLong)
+	}
+
 	// Validate location if provided
 	if i.Location != nil {
 		if i.Location.Latitude < domain.MinLatitude || i.Location.Latitude > domain.MaxLatitude {
-			return NewInvalidInputError("location.latitude", "must be between -90 and 90")
+			errs.Add(domain.ErrInvalidLatitude.WithField("location.latitude"))
 		}
 		if i.Location.Longitude < domain.MinLongitude || i.Location.Longitude > domain.MaxLongitude {
-			return NewInvalidInputError("location.longitude", "must be between -180 and 180")
+			errs.Add(domain.ErrInvalidLongitude.WithField("location.longitude"))
 		}
 		if utf8.RuneCountInString(i.Location.Address) > domain.MaxAddressLength {
-			return NewInvalidInputError("location.address", fmt.Sprintf("exceeds maximum length of %d", domain.MaxAddressLength))
+			errs.Add(domain.ErrAddressTooLong.WithField("location.address"))
 		}
 	}
 
 	// Validate voice if provided
 	if i.Voice != nil {
 		if i.Voice.Enabled && strings.TrimSpace(i.Voice.ModelName) == "" {
-			return NewInvalidInputError("voice.modelName", "cannot be empty when voice is enabled")
+			errs.Add(domain.ErrEmptyModelName.WithField("voice.modelName"))
 		}
 		if utf8.RuneCountInString(i.Voice.ModelName) > domain.MaxModelNameLength {
-			return NewInvalidInputError("voice.modelName", fmt.Sprintf("exceeds maximum length of %d", domain.MaxModelNameLength))
+			errs.Add(domain.ErrModelNameTooLong.WithField("voice.modelName"))
 		}
 	}
 
+	if len(errs) > 0 {
+		return NewValidationError(errs)
+	}
 	return nil
 }
 
@@ -120,3 +130,365 @@ type CreateOutput struct {
 	CreatedAt string          `json:"createdAt"`
 	UpdatedAt string          `json:"updatedAt"`
 }
+
+// ============================================================================
+// Summary DTOs - Period Aggregation
+// ============================================================================
+
+// SummaryPeriod selects the reporting window of a summary.
+type SummaryPeriod string
+
+const (
+	// SummaryPeriodWeek is the Monday-to-Sunday week containing the anchor date.
+	SummaryPeriodWeek SummaryPeriod = "week"
+	// SummaryPeriodMonth is the calendar month containing the anchor date.
+	SummaryPeriodMonth SummaryPeriod = "month"
+	// SummaryPeriodCustom is the inclusive StartDate..EndDate range.
+	SummaryPeriodCustom SummaryPeriod = "custom"
+)
+
+// MaxSummaryDays is the longest range a summary may cover.
+const MaxSummaryDays = 366
+
+// SummaryInput is the input DTO for summarizing a period.
+type SummaryInput struct {
+	Period    SummaryPeriod
+	Date      string // Anchor date (YYYY-MM-DD) for week and month periods
+	StartDate string // First day (YYYY-MM-DD) for custom periods
+	EndDate   string // Last day (YYYY-MM-DD) for custom periods
+}
+
+// Validate performs early validation on the input DTO.
+func (i *SummaryInput) Validate() error {
+	if i == nil {
+		return ErrNilInput
+	}
+
+	switch i.Period {
+	case SummaryPeriodWeek, SummaryPeriodMonth:
+		if strings.TrimSpace(i.Date) == "" {
+			return NewInvalidInputError("date", "cannot be empty")
+		}
+	case SummaryPeriodCustom:
+		if strings.TrimSpace(i.StartDate) == "" {
+			return NewInvalidInputError("startDate", "cannot be empty")
+		}
+		if strings.TrimSpace(i.EndDate) == "" {
+			return NewInvalidInputError("endDate", "cannot be empty")
+		}
+	default:
+		return NewInvalidInputError("period", fmt.Sprintf("must be one of %q, %q or %q",
+			SummaryPeriodWeek, SummaryPeriodMonth, SummaryPeriodCustom))
+	}
+
+	return nil
+}
+
+// TagFrequency counts the reports carrying a tag.
+type TagFrequency struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// LocationVisit counts the reports attached to a distinct location.
+type LocationVisit struct {
+	Address   string  `json:"address,omitempty"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Count     int     `json:"count"`
+}
+
+// ModelUsage counts the voice-enabled reports using a model.
+type ModelUsage struct {
+	Model string `json:"model"`
+	Count int    `json:"count"`
+}
+
+// VoiceUsage summarizes voice input across the period.
+type VoiceUsage struct {
+	Reports int          `json:"reports"`
+	Models  []ModelUsage `json:"models"`
+}
+
+// SummaryEntry is a single report in the summary, in date order.
+type SummaryEntry struct {
+	ID      string   `json:"id"`
+	Date    string   `json:"date"`
+	Content string   `json:"content"`
+	Tags    []string `json:"tags"`
+}
+
+// SummaryOutput is the output DTO for a period summary.
+type SummaryOutput struct {
+	Period            SummaryPeriod   `json:"period"`
+	StartDate         string          `json:"startDate"`
+	EndDate           string          `json:"endDate"`
+	ReportCount       int             `json:"reportCount"`
+	DaysReported      int             `json:"daysReported"`
+	DaysWithoutReport int             `json:"daysWithoutReport"`
+	MissingDates      []string        `json:"missingDates"`
+	TagFrequencies    []TagFrequency  `json:"tagFrequencies"`
+	Locations         []LocationVisit `json:"locations"`
+	Voice             VoiceUsage      `json:"voice"`
+	Entries           []SummaryEntry  `json:"entries"`
+	Markdown          string          `json:"markdown"`
+}
+
+// ============================================================================
+// Missing Report DTOs - Business Day Coverage
+// ============================================================================
+
+// MissingReportInput is the input DTO for listing business days without a report.
+type MissingReportInput struct {
+	StartDate string // First day (YYYY-MM-DD)
+	EndDate   string // Last day (YYYY-MM-DD)
+}
+
+// Validate performs early validation on the input DTO.
+func (i *MissingReportInput) Validate() error {
+	if i == nil {
+		return ErrNilInput
+	}
+	if strings.TrimSpace(i.StartDate) == "" {
+		return NewInvalidInputError("startDate", "cannot be empty")
+	}
+	if strings.TrimSpace(i.EndDate) == "" {
+		return NewInvalidInputError("endDate", "cannot be empty")
+	}
+	return nil
+}
+
+// MissingDate is a business day on which no report was filed.
+type MissingDate struct {
+	Date    string `json:"date"`
+	Weekday string `json:"weekday"`
+}
+
+// MissingReportOutput is the output DTO for missing-report detection.
+type MissingReportOutput struct {
+	StartDate    string        `json:"startDate"`
+	EndDate      string        `json:"endDate"`
+	BusinessDays int           `json:"businessDays"` // Business days checked (up to today)
+	ReportedDays int           `json:"reportedDays"` // Business days with at least one report
+	MissingDates []MissingDate `json:"missingDates"`
+}
+
+// ============================================================================
+// Comment DTOs - Feedback Thread
+// ============================================================================
+
+// AddCommentInput is the input DTO for commenting on a Nippou.
+type AddCommentInput struct {
+	NippouID string
+	Author   string
+	Body     string
+}
+
+// Validate performs early validation on the input DTO.
+func (i *AddCommentInput) Validate() error {
+	if i == nil {
+		return ErrNilInput
+	}
+	if strings.TrimSpace(i.NippouID) == "" {
+		return NewInvalidInputError("nippouId", "cannot be empty")
+	}
+	if strings.TrimSpace(i.Author) == "" {
+		return NewInvalidInputError("author", "cannot be empty")
+	}
+	if strings.TrimSpace(i.Body) == "" {
+		return NewInvalidInputError("body", "cannot be empty")
+	}
+	if utf8.RuneCountInString(i.Body) > domain.MaxCommentLength {
+		return NewInvalidInputError("body", fmt.Sprintf("exceeds maximum length of %d characters", domain.MaxCommentLength))
+	}
+	return nil
+}
+
+// ListCommentsInput is the input DTO for listing the comments on a Nippou.
+type ListCommentsInput struct {
+	NippouID string
+}
+
+// Validate performs early validation on the input DTO.
+func (i *ListCommentsInput) Validate() error {
+	if i == nil {
+		return ErrNilInput
+	}
+	if strings.TrimSpace(i.NippouID) == "" {
+		return NewInvalidInputError("nippouId", "cannot be empty")
+	}
+	return nil
+}
+
+// DeleteCommentInput is the input DTO for deleting a comment.
+type DeleteCommentInput struct {
+	CommentID string
+}
+
+// Validate performs early validation on the input DTO.
+func (i *DeleteCommentInput) Validate() error {
+	if i == nil {
+		return ErrNilInput
+	}
+	if strings.TrimSpace(i.CommentID) == "" {
+		return NewInvalidInputError("commentId", "cannot be empty")
+	}
+	return nil
+}
+
+// CommentOutput is a comment in responses.
+type CommentOutput struct {
+	ID        string `json:"id"`
+	NippouID  string `json:"nippouId"`
+	Author    string `json:"author"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// ListCommentsOutput is the output DTO for listing comments.
+type ListCommentsOutput struct {
+	NippouID string          `json:"nippouId"`
+	Comments []CommentOutput `json:"comments"`
+}
+
+// ============================================================================
+// Update & History DTOs - Revision Log
+// ============================================================================
+
+// UpdateInput is the input DTO for editing a Nippou. Nil fields are left
+// unchanged; every change is recorded as a revision by Author.
+type UpdateInput struct {
+	ID             string
+	Author         string
+	Content        *string
+	Tags           []string // Replaces all tags when non-nil; empty removes them
+	Location       *LocationInput
+	RemoveLocation bool
+}
+
+// Validate performs early validation on the input DTO.
+func (i *UpdateInput) Validate() error {
+	if i == nil {
+		return ErrNilInput
+	}
+	if strings.TrimSpace(i.ID) == "" {
+		return NewInvalidInputError("id", "cannot be empty")
+	}
+	if strings.TrimSpace(i.Author) == "" {
+		return NewInvalidInputError("author", "cannot be empty")
+	}
+	if i.Content == nil && i.Tags == nil && i.Location == nil && !i.RemoveLocation {
+		return NewInvalidInputError("input", "no changes requested")
+	}
+	if i.Content != nil && utf8.RuneCountInString(*i.Content) > domain.MaxContentLength {
+		return NewInvalidInputError("content", fmt.Sprintf("exceeds maximum length of %d characters", domain.MaxContentLength))
+	}
+	if len(i.Tags) > domain.MaxTagCount {
+		return NewInvalidInputError("tags", fmt.Sprintf("exceeds maximum count of %d", domain.MaxTagCount))
+	}
+	if i.Location != nil && i.RemoveLocation {
+		return NewInvalidInputError("location", "cannot set and remove the location at once")
+	}
+	return nil
+}
+
+// RevisionOutput is a revision in responses. In history listings Number
+// counts the revisions of one field from 1; revision 0 is the value before
+// the first recorded edit.
+type RevisionOutput struct {
+	Number    int    `json:"number,omitempty"`
+	ID        string `json:"id"`
+	Field     string `json:"field"`
+	Author    string `json:"author"`
+	ChangedAt string `json:"changedAt"`
+	Previous  string `json:"previous"`
+	Current   string `json:"current"`
+}
+
+// UpdateOutput is the output DTO for an edit.
+type UpdateOutput struct {
+	Nippou    *CreateOutput    `json:"nippou"`
+	Revisions []RevisionOutput `json:"revisions"` // Revisions recorded by this edit
+}
+
+// HistoryInput is the input DTO for the revision history of a Nippou.
+// When From or To is set, the output includes a line diff between those
+// revision numbers; an unset To means the latest revision.
+type HistoryInput struct {
+	NippouID string
+	Field    string // content (default), tags or location
+	From     *int
+	To       *int
+}
+
+// Validate performs early validation on the input DTO.
+func (i *HistoryInput) Validate() error {
+	if i == nil {
+		return ErrNilInput
+	}
+	if strings.TrimSpace(i.NippouID) == "" {
+		return NewInvalidInputError("nippouId", "cannot be empty")
+	}
+	if i.Field != "" && !domain.RevisionField(i.Field).IsValid() {
+		return NewInvalidInputError("field", "must be content, tags or location")
+	}
+	return nil
+}
+
+// DiffOutput is a line diff between two revisions.
+type DiffOutput struct {
+	From    int      `json:"from"`
+	To      int      `json:"to"`
+	Added   int      `json:"added"`
+	Removed int      `json:"removed"`
+	Lines   []string `json:"lines"` // Prefixed with "+ ", "- " or "  "
+}
+
+// HistoryOutput is the output DTO for the revision history.
+type HistoryOutput struct {
+	NippouID  string           `json:"nippouId"`
+	Field     string           `json:"field"`
+	Revisions []RevisionOutput `json:"revisions"`
+	Diff      *DiffOutput      `json:"diff,omitempty"`
+}
+
+// ============================================================================
+// Read DTOs
+// ============================================================================
+
+// ReadInput is the input DTO for loading reports. Exactly one selector must
+// be set: ID, Date, Week (ISO "2024-W03") or the StartDate/EndDate pair.
+type ReadInput struct {
+	ID        string
+	Date      string
+	Week      string
+	StartDate string
+	EndDate   string
+}
+
+// Validate performs early validation on the input DTO.
+func (i *ReadInput) Validate() error {
+	if i == nil {
+		return ErrNilInput
+	}
+	selectors := 0
+	for _, s := range []string{i.ID, i.Date, i.Week} {
+		if s != "" {
+			selectors++
+		}
+	}
+	if i.StartDate != "" || i.EndDate != "" {
+		selectors++
+	}
+	if selectors != 1 {
+		return NewInvalidInputError("input", "exactly one of id, date, week or startDate/endDate is required")
+	}
+	return nil
+}
+
+// ReadOutput is the output DTO for loaded reports.
+type ReadOutput struct {
+	StartDate string          `json:"startDate"`
+	EndDate   string          `json:"endDate"`
+	Nippous   []*CreateOutput `json:"nippous"`
+}
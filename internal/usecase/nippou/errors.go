@@ -3,6 +3,8 @@ package nippou
 import (
 	"errors"
 	"fmt"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
 )
 
 // ============================================================================
@@ -13,6 +15,7 @@ import (
 type UseCaseError struct {
 	Code    string
 	Message string
+	Field   string // Input field at fault, for INVALID_INPUT errors
 	Cause   error
 }
 
@@ -33,6 +36,7 @@ const (
 	ErrCodeRepositoryError  = "REPOSITORY_ERROR"
 	ErrCodeDomainViolation  = "DOMAIN_VIOLATION"
 	ErrCodeContextCancelled = "CONTEXT_CANCELLED"
+	ErrCodeNotFound         = "NOT_FOUND"
 )
 
 // Predefined usecase errors.
@@ -40,6 +44,14 @@ var (
 	ErrNilInput      = &UseCaseError{Code: ErrCodeInvalidInput, Message: "input cannot be nil"}
 	ErrContextNil    = &UseCaseError{Code: ErrCodeInvalidInput, Message: "context cannot be nil"}
 	ErrRepositoryNil = &UseCaseError{Code: ErrCodeInvalidInput, Message: "repository cannot be nil"}
+	ErrFutureDate    = &UseCaseError{Code: ErrCodeInvalidInput, Field: "date", Message: "date: must not be in the future"}
+)
+
+// Date entries of a domain.ValidationErrors. errDateInFuture stands for
+// ErrFutureDate when the date is reported together with other problems.
+var (
+	errEmptyDate    = &domain.DomainError{Code: domain.ErrCodeValidation, Field: "date", Message: "date cannot be empty"}
+	errDateInFuture = &domain.DomainError{Code: domain.ErrCodeValidation, Field: "date", Message: "date must not be in the future"}
 )
 
 // NewInvalidInputError creates an input validation error.
@@ -47,9 +59,24 @@ func NewInvalidInputError(field, message string) *UseCaseError {
 	return &UseCaseError{
 		Code:    ErrCodeInvalidInput,
 		Message: fmt.Sprintf("%s: %s", field, message),
+		Field:   field,
 	}
 }
 
+// NewValidationError reports every problem found in an input at once. Field
+// is the first field at fault; errors.Is and errors.As reach each entry.
+func NewValidationError(errs domain.ValidationErrors) *UseCaseError {
+	err := &UseCaseError{
+		Code:    ErrCodeInvalidInput,
+		Message: "input validation failed",
+		Cause:   errs,
+	}
+	if fields := errs.Fields(); len(fields) > 0 {
+		err.Field = fields[0]
+	}
+	return err
+}
+
 // NewRepositoryError wraps a repository error.
 func NewRepositoryError(cause error) *UseCaseError {
 	return &UseCaseError{
@@ -59,6 +86,33 @@ func NewRepositoryError(cause error) *UseCaseError {
 	}
 }
 
+// NewRepositoryReadError wraps a repository error raised while loading.
+func NewRepositoryReadError(cause error) *UseCaseError {
+	return &UseCaseError{
+		Code:    ErrCodeRepositoryError,
+		Message: "failed to load nippou",
+		Cause:   cause,
+	}
+}
+
+// NewCommentRepositoryError wraps a comment repository error raised while
+// performing action, e.g. "save" or "delete".
+func NewCommentRepositoryError(action string, cause error) *UseCaseError {
+	return &UseCaseError{
+		Code:    ErrCodeRepositoryError,
+		Message: fmt.Sprintf("failed to %s comment", action),
+		Cause:   cause,
+	}
+}
+
+// NewNotFoundError creates an error for a missing resource.
+func NewNotFoundError(resource, id string) *UseCaseError {
+	return &UseCaseError{
+		Code:    ErrCodeNotFound,
+		Message: fmt.Sprintf("%s %s not found", resource, id),
+	}
+}
+
 // NewDomainViolationError wraps a domain error.
 func NewDomainViolationError(cause error) *UseCaseError {
 	return &UseCaseError{
@@ -2,6 +2,11 @@ package nippou
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
 
 	domain "salesforce-mcp-server/internal/domain/nippou"
 )
@@ -12,7 +17,11 @@ import (
 
 // CreateUseCase handles the creation of Nippou entities.
 type CreateUseCase struct {
-	repo domain.Repository
+	repo      domain.Repository
+	dates     *DateResolver
+	templates map[string]*domain.Template
+	events    domain.EventDispatcher
+	logger    *slog.Logger
 }
 
 // NewCreateUseCase creates a new CreateUseCase with the given repository.
@@ -20,7 +29,46 @@ func NewCreateUseCase(repo domain.Repository) (*CreateUseCase, error) {
 	if repo == nil {
 		return nil, ErrRepositoryNil
 	}
-	return &CreateUseCase{repo: repo}, nil
+	uc := &CreateUseCase{
+		repo:      repo,
+		dates:     NewDateResolver(),
+		templates: make(map[string]*domain.Template),
+		logger:    slog.New(slog.DiscardHandler),
+	}
+	uc.WithTemplates(domain.StandardTemplate())
+	return uc, nil
+}
+
+// WithTemplates registers report templates selectable by CreateInput.Template.
+// A template replaces any registered template with the same name.
+func (uc *CreateUseCase) WithTemplates(templates ...*domain.Template) *CreateUseCase {
+	for _, t := range templates {
+		if t != nil {
+			uc.templates[t.Name()] = t
+		}
+	}
+	return uc
+}
+
+// WithDateResolver sets the resolver for relative dates such as "yesterday".
+func (uc *CreateUseCase) WithDateResolver(r *DateResolver) *CreateUseCase {
+	uc.dates = r
+	return uc
+}
+
+// WithEventDispatcher sets the dispatcher that receives the domain events of
+// each saved Nippou.
+func (uc *CreateUseCase) WithEventDispatcher(d domain.EventDispatcher) *CreateUseCase {
+	uc.events = d
+	return uc
+}
+
+// WithLogger sets the logger for saved reports and persistence failures.
+func (uc *CreateUseCase) WithLogger(logger *slog.Logger) *CreateUseCase {
+	if logger != nil {
+		uc.logger = logger
+	}
+	return uc
 }
 
 // Execute creates a new Nippou based on the input.
@@ -47,8 +95,25 @@ func (uc *CreateUseCase) Execute(ctx context.Context, input *CreateInput) (*Crea
 		return nil, err
 	}
 
-	// Step 2: Build domain entity using the builder
-	builder := domain.NewNippouBuilder(input.Date, input.Content)
+	// Step 2: Resolve relative dates ("yesterday", 先週金曜) to a calendar date.
+	// A date error alone is returned as is; otherwise it is reported with the
+	// other problems below.
+	date, dateErr := uc.dates.Resolve(input.Date)
+
+	// Look up the template before doing any further work
+	var template *domain.Template
+	if name := strings.TrimSpace(input.Template); name != "" {
+		t, ok := uc.templates[name]
+		if !ok {
+			return nil, NewInvalidInputError("template", fmt.Sprintf("unknown template %q", name))
+		}
+		template = t
+	}
+
+	// Build domain entity using the builder. Value object errors are
+	// collected with the builder's own so that all are reported together.
+	builder := domain.NewNippouBuilder(date.String(), input.Content)
+	var errs domain.ValidationErrors
 
 	// Step 3: Add optional location
 	if input.Location != nil {
@@ -57,33 +122,32 @@ func (uc *CreateUseCase) Execute(ctx context.Context, input *CreateInput) (*Crea
 			input.Location.Longitude,
 			input.Location.Address,
 		)
-		if err != nil {
-			return nil, NewDomainViolationError(err)
-		}
+		errs.Add(relabelIn(err, "location"))
 		builder.WithLocation(loc)
 	}
 
 	// Step 4: Add optional voice config
 	if input.Voice != nil {
 		voice, err := domain.NewVoiceConfig(input.Voice.Enabled, input.Voice.ModelName)
-		if err != nil {
-			return nil, NewDomainViolationError(err)
-		}
+		errs.Add(relabelIn(err, "voice"))
 		builder.WithVoice(voice)
 	}
 
 	// Step 5: Add tags
 	if len(input.Tags) > 0 {
 		tags := make([]domain.Tag, 0, len(input.Tags))
-		for _, tagStr := range input.Tags {
+	nextTag:
+		for idx, tagStr := range input.Tags {
 			tag, err := domain.NewTag(tagStr)
 			if err != nil {
-				return nil, NewDomainViolationError(err)
+				errs.Add(relabel(err, fmt.Sprintf("tags[%d]", idx)))
+				continue
 			}
 			// Check for duplicate tags
 			for _, existingTag := range tags {
 				if existingTag.Equals(tag) {
-					return nil, NewDomainViolationError(domain.ErrDuplicateTag)
+					errs.Add(domain.ErrDuplicateTag.WithField(fmt.Sprintf("tags[%d]", idx)))
+					continue nextTag
 				}
 			}
 			tags = append(tags, tag)
@@ -91,10 +155,32 @@ func (uc *CreateUseCase) Execute(ctx context.Context, input *CreateInput) (*Crea
 		builder.WithTags(tags)
 	}
 
-	// Step 6: Build the entity
+	// Step 6: Build the entity. Without a resolved date the builder reports
+	// the date again, so only its other errors are kept.
 	nippou, err := builder.Build()
-	if err != nil {
-		return nil, NewDomainViolationError(err)
+	var buildErrs domain.ValidationErrors
+	buildErrs.Add(err)
+	for _, e := range buildErrs {
+		if dateErr == nil || e.Field != "date" {
+			errs = append(errs, e)
+		}
+	}
+
+	// Step 6b: Check the content against the template, normalized as the
+	// builder does, so its problems are reported with the others
+	if template != nil {
+		if content, err := domain.MultilineText.Normalize("content", input.Content); err == nil && content != "" {
+			errs.Add(template.Validate(content))
+		}
+	}
+	if dateErr != nil {
+		if len(errs) == 0 {
+			return nil, dateErr
+		}
+		errs = append(domain.ValidationErrors{dateViolation(dateErr)}, errs...)
+	}
+	if len(errs) > 0 {
+		return nil, NewDomainViolationError(errs)
 	}
 
 	// Check context before repository operation
@@ -110,14 +196,60 @@ func (uc *CreateUseCase) Execute(ctx context.Context, input *CreateInput) (*Crea
 
 	// Step 7: Persist to repository
 	if err := uc.repo.Save(nippou); err != nil {
+		uc.logger.ErrorContext(ctx, "failed to save nippou", "date", nippou.Date().String(), "error", err)
 		return nil, NewRepositoryError(err)
 	}
+	uc.logger.InfoContext(ctx, "nippou created",
+		"id", nippou.ID().String(), "date", nippou.Date().String(), "tags", len(nippou.Tags()))
+	dispatchEvents(ctx, uc.events, nippou)
 
 	// Step 8: Map to output DTO
 	return mapToOutput(nippou), nil
 }
 
+// relabel reports a domain error against an input path such as "tags[1]".
+func relabel(err error, field string) error {
+	var domErr *domain.DomainError
+	if errors.As(err, &domErr) {
+		return domErr.WithField(field)
+	}
+	return err
+}
+
+// relabelIn reports a domain error of a nested input, such as "address",
+// against its path under parent ("location.address").
+func relabelIn(err error, parent string) error {
+	var domErr *domain.DomainError
+	if errors.As(err, &domErr) && domErr.Field != "" {
+		return domErr.WithField(parent + "." + domErr.Field)
+	}
+	return err
+}
+
+// dateViolation converts a DateResolver error to a "date" validation entry.
+func dateViolation(err error) *domain.DomainError {
+	if errors.Is(err, ErrFutureDate) {
+		return errDateInFuture
+	}
+	var domErr *domain.DomainError
+	if errors.As(err, &domErr) {
+		return domErr.WithField("date")
+	}
+	return &domain.DomainError{Code: domain.ErrCodeValidation, Field: "date", Message: err.Error()}
+}
+
+// dispatchEvents drains the events of a saved Nippou and hands them to d.
+// Events are drained even without a dispatcher so they never pile up.
+func dispatchEvents(ctx context.Context, d domain.EventDispatcher, n *domain.Nippou) {
+	events := n.PullEvents()
+	if d != nil && len(events) > 0 {
+		d.Dispatch(ctx, events)
+	}
+}
+
 // mapToOutput converts a domain Nippou to the output DTO.
+// Timestamps are instants and are always rendered in UTC; Date is a calendar
+// date and carries no zone.
 func mapToOutput(n *domain.Nippou) *CreateOutput {
 	if n == nil {
 		return nil
@@ -125,11 +257,11 @@ func mapToOutput(n *domain.Nippou) *CreateOutput {
 
 	output := &CreateOutput{
 		ID:        n.ID().String(),
-		Date:      n.Date().Format("2006-01-02"),
+		Date:      n.Date().String(),
 		Content:   n.Content(),
 		Tags:      n.TagStrings(),
-		CreatedAt: n.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: n.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt: n.CreatedAt().UTC().Format(time.RFC3339),
+		UpdatedAt: n.UpdatedAt().UTC().Format(time.RFC3339),
 	}
 
 	// Ensure tags is never nil in output
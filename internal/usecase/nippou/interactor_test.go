@@ -1,12 +1,15 @@
 package nippou
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
+	"reflect"
 	"strings"
 	"testing"
-	"time"
 
 	domain "salesforce-mcp-server/internal/domain/nippou"
 )
@@ -19,7 +22,7 @@ import (
 type MockRepository struct {
 	SaveFunc     func(n *domain.Nippou) error
 	FindByIDFunc func(id domain.ID) (*domain.Nippou, error)
-	FindByDateFunc func(date time.Time) ([]*domain.Nippou, error)
+	FindByDateFunc func(date domain.CivilDate) ([]*domain.Nippou, error)
 	DeleteFunc   func(id domain.ID) error
 	SaveCalled   int
 	LastSaved    *domain.Nippou
@@ -41,7 +44,7 @@ func (m *MockRepository) FindByID(id domain.ID) (*domain.Nippou, error) {
 	return nil, nil
 }
 
-func (m *MockRepository) FindByDate(date time.Time) ([]*domain.Nippou, error) {
+func (m *MockRepository) FindByDate(date domain.CivilDate) ([]*domain.Nippou, error) {
 	if m.FindByDateFunc != nil {
 		return m.FindByDateFunc(date)
 	}
@@ -396,6 +399,32 @@ func TestCreateInput_Validate_ModelNameTooLong(t *testing.T) {
 	}
 }
 
+func TestCreateInput_Validate_ReportsAllErrors(t *testing.T) {
+	input := &CreateInput{
+		Date:     " ",
+		Content:  "",
+		Tags:     []string{"valid", strings.Repeat("a", domain.MaxTagLength+1)},
+		Location: &LocationInput{Latitude: 91, Longitude: 181},
+	}
+	err := input.Validate()
+
+	var errs domain.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Validate() error = %v, want ValidationErrors", err)
+	}
+	want := []string{"date", "content", "tags[1]", "location.latitude", "location.longitude"}
+	if got := errs.Fields(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields() = %v, want %v", got, want)
+	}
+	if !errors.Is(err, domain.ErrEmptyContent) || !errors.Is(err, domain.ErrTagTooLong) {
+		t.Errorf("errors.Is should match every collected error: %v", err)
+	}
+	var ucErr *UseCaseError
+	if !errors.As(err, &ucErr) || ucErr.Code != ErrCodeInvalidInput || ucErr.Field != "date" {
+		t.Errorf("Validate() error = %#v, want INVALID_INPUT on the first field", ucErr)
+	}
+}
+
 // ============================================================================
 // NewCreateUseCase Tests
 // ============================================================================
@@ -642,6 +671,74 @@ func TestExecute_DuplicateTags(t *testing.T) {
 	}
 }
 
+func TestExecute_ReportsAllDomainErrors(t *testing.T) {
+	repo := &MockRepository{}
+	uc, _ := NewCreateUseCase(repo)
+
+	input := &CreateInput{
+		Date:    "2026-01-08",
+		Content: "content",
+		Tags:    []string{"tag1", "bad tag", "TAG1"},
+	}
+	_, err := uc.Execute(context.Background(), input)
+	if !IsDomainViolation(err) {
+		t.Fatalf("Execute() should return domain violation, got: %v", err)
+	}
+	var errs domain.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Execute() error = %v, want ValidationErrors", err)
+	}
+	want := []string{"tags[1]", "tags[2]"}
+	if got := errs.Fields(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields() = %v, want %v", got, want)
+	}
+	if !errors.Is(err, domain.ErrDuplicateTag) {
+		t.Errorf("errors.Is(err, ErrDuplicateTag) = false: %v", err)
+	}
+	if repo.SaveCalled != 0 {
+		t.Errorf("Save called %d times, want 0", repo.SaveCalled)
+	}
+}
+
+func TestExecute_ReportsDateWithOtherErrors(t *testing.T) {
+	repo := &MockRepository{}
+	uc, _ := NewCreateUseCase(repo)
+	uc.WithDateResolver(fixedResolver())
+
+	tests := []struct {
+		name string
+		date string
+		want []string
+	}{
+		{"invalid date", "not a date", []string{"date", "location.address", "tags[0]"}},
+		{"future date", "tomorrow", []string{"date", "location.address", "tags[0]"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &CreateInput{
+				Date:     tt.date,
+				Content:  "content",
+				Tags:     []string{"bad tag"},
+				Location: &LocationInput{Latitude: 35, Longitude: 139, Address: "Tokyo\xff"},
+			}
+			_, err := uc.Execute(context.Background(), input)
+			if !IsDomainViolation(err) {
+				t.Fatalf("Execute() should return domain violation, got: %v", err)
+			}
+			var errs domain.ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("Execute() error = %v, want ValidationErrors", err)
+			}
+			if got := errs.Fields(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if repo.SaveCalled != 0 {
+		t.Errorf("Save called %d times, want 0", repo.SaveCalled)
+	}
+}
+
 func TestExecute_RepositoryError(t *testing.T) {
 	repoErr := errors.New("database connection failed")
 	repo := &MockRepository{
@@ -903,6 +1000,9 @@ func TestNewInvalidInputError(t *testing.T) {
 	if !strings.Contains(err.Message, "message") {
 		t.Error("Message should contain message")
 	}
+	if err.Field != "field" {
+		t.Errorf("Field = %q, want %q", err.Field, "field")
+	}
 }
 
 func TestNewRepositoryError(t *testing.T) {
@@ -1051,3 +1151,139 @@ func TestExecute_BoundaryCoordinates(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================================
+// Template Tests
+// ============================================================================
+
+func TestExecute_Template(t *testing.T) {
+	repo := &MockRepository{}
+	uc, _ := NewCreateUseCase(repo)
+
+	content := "## 本日の業務\nA社訪問と見積書の提出\n## 成果\n受注\n## 課題\n納期調整"
+	if _, err := uc.Execute(context.Background(), &CreateInput{Date: "2026-01-08", Content: content, Template: "standard"}); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	_, err := uc.Execute(context.Background(), &CreateInput{Date: "2026-01-08", Content: "## 成果\n受注", Template: "standard"})
+	if !IsDomainViolation(err) {
+		t.Errorf("Execute() error = %v, want domain violation", err)
+	}
+	var domErr *domain.DomainError
+	if !errors.As(err, &domErr) || !strings.HasPrefix(domErr.Field, "content.") {
+		t.Errorf("expected field-level DomainError, got %v", err)
+	}
+	if repo.SaveCalled != 1 {
+		t.Errorf("Save() called %d times, want 1", repo.SaveCalled)
+	}
+}
+
+func TestExecute_TemplateReportedWithOtherErrors(t *testing.T) {
+	repo := &MockRepository{}
+	uc, _ := NewCreateUseCase(repo)
+
+	_, err := uc.Execute(context.Background(), &CreateInput{
+		Date:     "2026-01-08",
+		Content:  "## 成果\n受注",
+		Template: "standard",
+		Tags:     []string{"bad tag"},
+	})
+	var errs domain.ValidationErrors
+	if !IsDomainViolation(err) || !errors.As(err, &errs) {
+		t.Fatalf("Execute() error = %v, want domain violation with ValidationErrors", err)
+	}
+	want := "tags[0],content.本日の業務,content.課題"
+	if got := strings.Join(errs.Fields(), ","); got != want {
+		t.Errorf("fields = %s, want %s", got, want)
+	}
+	if repo.SaveCalled != 0 {
+		t.Errorf("Save() called %d times, want 0", repo.SaveCalled)
+	}
+}
+
+func TestExecute_UnknownTemplate(t *testing.T) {
+	repo := &MockRepository{}
+	uc, _ := NewCreateUseCase(repo)
+
+	_, err := uc.Execute(context.Background(), &CreateInput{Date: "2026-01-08", Content: "content", Template: "weekly"})
+	var ucErr *UseCaseError
+	if !errors.As(err, &ucErr) || ucErr.Code != ErrCodeInvalidInput {
+		t.Errorf("Execute() error = %v, want INVALID_INPUT", err)
+	}
+
+	custom, _ := domain.NewTemplate("weekly", []domain.TemplateSection{{Heading: "Summary", Required: true}})
+	uc.WithTemplates(custom)
+	if _, err := uc.Execute(context.Background(), &CreateInput{Date: "2026-01-08", Content: "# Summary\nok", Template: "weekly"}); err != nil {
+		t.Errorf("Execute() with registered template error = %v", err)
+	}
+}
+
+// ============================================================================
+// Domain Event Tests
+// ============================================================================
+
+// MockEventDispatcher records dispatched events.
+type MockEventDispatcher struct {
+	Events []domain.Event
+}
+
+func (m *MockEventDispatcher) Dispatch(ctx context.Context, events []domain.Event) {
+	m.Events = append(m.Events, events...)
+}
+
+func TestExecute_DispatchesEventsAfterSave(t *testing.T) {
+	dispatcher := &MockEventDispatcher{}
+	failing := &MockRepository{SaveFunc: func(n *domain.Nippou) error { return errors.New("timeout") }}
+	uc, _ := NewCreateUseCase(failing)
+	uc.WithEventDispatcher(dispatcher)
+
+	input := &CreateInput{Date: "2026-01-08", Content: "content", Tags: []string{"sales"}}
+	if _, err := uc.Execute(context.Background(), input); err == nil {
+		t.Fatal("Execute() expected error")
+	}
+	if len(dispatcher.Events) != 0 {
+		t.Fatalf("events dispatched for failed save: %d", len(dispatcher.Events))
+	}
+
+	repo := &MockRepository{}
+	uc, _ = NewCreateUseCase(repo)
+	uc.WithEventDispatcher(dispatcher)
+	if _, err := uc.Execute(context.Background(), input); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(dispatcher.Events) != 1 || dispatcher.Events[0].EventName() != domain.EventNippouCreated {
+		t.Errorf("events = %v, want [NippouCreated]", dispatcher.Events)
+	}
+	if repo.LastSaved.Events() != nil {
+		t.Error("events should be drained after dispatch")
+	}
+}
+
+func TestExecute_Logging(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	repo := &MockRepository{}
+	uc, _ := NewCreateUseCase(repo)
+	uc.WithLogger(logger)
+
+	output, err := uc.Execute(context.Background(), &CreateInput{Date: "2026-01-08", Content: "content", Tags: []string{"sales"}})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("log %s: %v", buf.String(), err)
+	}
+	if entry["msg"] != "nippou created" || entry["id"] != output.ID || entry["date"] != "2026-01-08" || entry["tags"] != float64(1) {
+		t.Errorf("log entry = %v", entry)
+	}
+
+	buf.Reset()
+	repo.SaveFunc = func(n *domain.Nippou) error { return errors.New("database connection failed") }
+	if _, err := uc.Execute(context.Background(), &CreateInput{Date: "2026-01-08", Content: "content"}); err == nil {
+		t.Fatal("Execute() expected error")
+	}
+	if !strings.Contains(buf.String(), `"level":"ERROR"`) || !strings.Contains(buf.String(), "database connection failed") {
+		t.Errorf("log = %s", buf.String())
+	}
+}
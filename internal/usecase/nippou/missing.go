@@ -0,0 +1,124 @@
+package nippou
+
+import (
+	"context"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/calendar"
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// MissingReport UseCase - Business Day Coverage
+// ============================================================================
+
+// MissingReportUseCase lists the business days in a range on which no Nippou
+// was filed. Days after today are not checked, so a range reaching into the
+// future only reports days that are already overdue.
+type MissingReportUseCase struct {
+	repo     domain.DateRangeReader
+	calendar *calendar.BusinessCalendar
+	location *time.Location
+	timeFunc func() time.Time
+}
+
+// NewMissingReportUseCase creates a new MissingReportUseCase. A nil calendar
+// uses calendar.DefaultConfig (weekends and Japanese national holidays).
+func NewMissingReportUseCase(repo domain.DateRangeReader, cal *calendar.BusinessCalendar) (*MissingReportUseCase, error) {
+	if repo == nil {
+		return nil, ErrRepositoryNil
+	}
+	if cal == nil {
+		cal = calendar.NewBusinessCalendar(nil)
+	}
+	return &MissingReportUseCase{
+		repo:     repo,
+		calendar: cal,
+		location: domain.DefaultLocation(),
+		timeFunc: time.Now,
+	}, nil
+}
+
+// WithLocation sets the user's time zone, which decides what "today" is.
+func (uc *MissingReportUseCase) WithLocation(loc *time.Location) *MissingReportUseCase {
+	uc.location = loc
+	return uc
+}
+
+// WithTimeFunc sets a custom time function (useful for testing).
+func (uc *MissingReportUseCase) WithTimeFunc(fn func() time.Time) *MissingReportUseCase {
+	uc.timeFunc = fn
+	return uc
+}
+
+// Execute lists the business days in the input range without a report.
+func (uc *MissingReportUseCase) Execute(ctx context.Context, input *MissingReportInput) (*MissingReportOutput, error) {
+	// Guard: Check context
+	if ctx == nil {
+		return nil, ErrContextNil
+	}
+
+	// Step 1: Validate input DTO and range
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	start, end, err := parseDateRange(input.StartDate, input.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &MissingReportOutput{
+		StartDate:    start.String(),
+		EndDate:      end.String(),
+		MissingDates: []MissingDate{},
+	}
+
+	// Step 2: Determine the business days to check
+	if today := domain.TodayIn(uc.timeFunc(), uc.location); today.Before(end) {
+		end = today
+	}
+	businessDays := uc.calendar.BusinessDays(start.In(time.UTC), end.In(time.UTC))
+	output.BusinessDays = len(businessDays)
+	if len(businessDays) == 0 {
+		return output, nil
+	}
+
+	// Check for context cancellation before repository operation
+	select {
+	case <-ctx.Done():
+		return nil, &UseCaseError{
+			Code:    ErrCodeContextCancelled,
+			Message: "operation cancelled",
+			Cause:   ctx.Err(),
+		}
+	default:
+	}
+
+	// Step 3: Load reports for the checked days only
+	nippous, err := uc.repo.FindByDateRange(domain.CivilDateOf(businessDays[0]), domain.CivilDateOf(businessDays[len(businessDays)-1]))
+	if err != nil {
+		return nil, NewRepositoryReadError(err)
+	}
+
+	reported := make(map[string]bool, len(nippous))
+	for _, n := range nippous {
+		if n != nil {
+			reported[n.Date().String()] = true
+		}
+	}
+
+	// Step 4: Collect business days without a report
+	for _, day := range businessDays {
+		date := day.Format("2006-01-02")
+		if reported[date] {
+			output.ReportedDays++
+			continue
+		}
+		output.MissingDates = append(output.MissingDates, MissingDate{
+			Date:    date,
+			Weekday: day.Weekday().String(),
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,133 @@
+package nippou
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/calendar"
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// MissingReportUseCase Tests
+// ============================================================================
+
+func TestNewMissingReportUseCase_NilRepository(t *testing.T) {
+	if _, err := NewMissingReportUseCase(nil, nil); err != ErrRepositoryNil {
+		t.Errorf("NewMissingReportUseCase(nil) error = %v, want ErrRepositoryNil", err)
+	}
+}
+
+func TestMissingReportUseCase_Execute(t *testing.T) {
+	reports := []*domain.Nippou{
+		summaryFixture(t, "2024-04-30", "report", nil, nil, nil),
+		summaryFixture(t, "2024-04-30", "second report", nil, nil, nil),
+		summaryFixture(t, "2024-05-02", "report", nil, nil, nil),
+	}
+	repo := &MockDateRangeReader{
+		FindByDateRangeFunc: func(startDate, endDate domain.CivilDate) ([]*domain.Nippou, error) {
+			return reports, nil
+		},
+	}
+	holiday, _ := calendar.ParseHoliday("2024-05-01", "Company holiday")
+	cal := calendar.NewBusinessCalendar(&calendar.Config{
+		Weekend:         []time.Weekday{time.Saturday, time.Sunday},
+		CompanyHolidays: []calendar.Holiday{holiday},
+	})
+	uc, _ := NewMissingReportUseCase(repo, cal)
+	uc.WithTimeFunc(func() time.Time { return time.Date(2024, 5, 8, 9, 0, 0, 0, time.UTC) })
+
+	output, err := uc.Execute(context.Background(), &MissingReportInput{StartDate: "2024-04-26", EndDate: "2024-05-10"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	// Business days up to 5/8: 4/26, 4/30, 5/2, 5/7, 5/8 (4/29 and 5/3-5/6 are national holidays)
+	if output.BusinessDays != 5 || output.ReportedDays != 2 {
+		t.Errorf("counts = %d business / %d reported", output.BusinessDays, output.ReportedDays)
+	}
+	want := []MissingDate{{"2024-04-26", "Friday"}, {"2024-05-07", "Tuesday"}, {"2024-05-08", "Wednesday"}}
+	if len(output.MissingDates) != len(want) {
+		t.Fatalf("MissingDates = %v, want %v", output.MissingDates, want)
+	}
+	for i := range want {
+		if output.MissingDates[i] != want[i] {
+			t.Errorf("MissingDates[%d] = %v, want %v", i, output.MissingDates[i], want[i])
+		}
+	}
+	if got := repo.LastStart.String() + ".." + repo.LastEnd.String(); got != "2024-04-26..2024-05-08" {
+		t.Errorf("queried range = %s", got)
+	}
+}
+
+func TestMissingReportUseCase_TodayInUserTimeZone(t *testing.T) {
+	repo := &MockDateRangeReader{}
+	uc, _ := NewMissingReportUseCase(repo, nil)
+	// 00:30 JST on Tuesday 2024-01-16 is still Monday in UTC
+	uc.WithTimeFunc(func() time.Time { return time.Date(2024, 1, 15, 15, 30, 0, 0, time.UTC) })
+	input := &MissingReportInput{StartDate: "2024-01-15", EndDate: "2024-01-19"}
+
+	output, err := uc.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.BusinessDays != 2 || repo.LastEnd.String() != "2024-01-16" {
+		t.Errorf("Tokyo: %d business days up to %s, want 2 up to 2024-01-16", output.BusinessDays, repo.LastEnd)
+	}
+
+	uc.WithLocation(time.UTC)
+	if output, _ = uc.Execute(context.Background(), input); output.BusinessDays != 1 {
+		t.Errorf("UTC: %d business days, want 1", output.BusinessDays)
+	}
+}
+
+func TestMissingReportUseCase_FutureRangeSkipsRepository(t *testing.T) {
+	repo := &MockDateRangeReader{
+		FindByDateRangeFunc: func(startDate, endDate domain.CivilDate) ([]*domain.Nippou, error) {
+			t.Error("repository should not be queried")
+			return nil, nil
+		},
+	}
+	uc, _ := NewMissingReportUseCase(repo, nil)
+	uc.WithTimeFunc(func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) })
+
+	output, err := uc.Execute(context.Background(), &MissingReportInput{StartDate: "2024-02-01", EndDate: "2024-02-29"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.BusinessDays != 0 || output.MissingDates == nil || len(output.MissingDates) != 0 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+}
+
+func TestMissingReportUseCase_Errors(t *testing.T) {
+	failing := &MockDateRangeReader{
+		FindByDateRangeFunc: func(startDate, endDate domain.CivilDate) ([]*domain.Nippou, error) {
+			return nil, errors.New("timeout")
+		},
+	}
+	uc, _ := NewMissingReportUseCase(failing, nil)
+	uc.WithTimeFunc(func() time.Time { return time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC) })
+
+	tests := []struct {
+		name  string
+		input *MissingReportInput
+		code  string
+	}{
+		{"nil input", nil, ErrCodeInvalidInput},
+		{"missing start", &MissingReportInput{EndDate: "2024-01-31"}, ErrCodeInvalidInput},
+		{"reversed range", &MissingReportInput{StartDate: "2024-01-31", EndDate: "2024-01-01"}, ErrCodeInvalidInput},
+		{"repository failure", &MissingReportInput{StartDate: "2024-01-01", EndDate: "2024-01-31"}, ErrCodeRepositoryError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(context.Background(), tt.input)
+			var ucErr *UseCaseError
+			if !errors.As(err, &ucErr) || ucErr.Code != tt.code {
+				t.Errorf("Execute() error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package nippou
+
+import (
+	"context"
+	"sort"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Read UseCase - Lookup by ID, Date, ISO Week or Range
+// ============================================================================
+
+// ReadUseCase loads reports for display, e.g. as MCP resources.
+type ReadUseCase struct {
+	reader domain.Reader
+	ranges domain.DateRangeReader
+}
+
+// NewReadUseCase creates a new ReadUseCase. ranges serves week and range
+// lookups and is usually the same repository as reader.
+func NewReadUseCase(reader domain.Reader, ranges domain.DateRangeReader) (*ReadUseCase, error) {
+	if reader == nil || ranges == nil {
+		return nil, ErrRepositoryNil
+	}
+	return &ReadUseCase{reader: reader, ranges: ranges}, nil
+}
+
+// Execute loads the reports selected by input, ordered by date and creation
+// time. A missing ID is NOT_FOUND; a date or week without reports is empty.
+func (uc *ReadUseCase) Execute(ctx context.Context, input *ReadInput) (*ReadOutput, error) {
+	// Guard: Check context
+	if ctx == nil {
+		return nil, ErrContextNil
+	}
+
+	// Step 1: Validate input DTO
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Check for context cancellation before repository operation
+	select {
+	case <-ctx.Done():
+		return nil, &UseCaseError{
+			Code:    ErrCodeContextCancelled,
+			Message: "operation cancelled",
+			Cause:   ctx.Err(),
+		}
+	default:
+	}
+
+	// Step 2: Load by the selected key
+	var (
+		nippous    []*domain.Nippou
+		start, end domain.CivilDate
+		err        error
+	)
+	switch {
+	case input.ID != "":
+		id, err := domain.IDFromString(input.ID)
+		if err != nil {
+			return nil, NewDomainViolationError(err)
+		}
+		n, err := uc.reader.FindByID(id)
+		if err != nil {
+			return nil, NewRepositoryReadError(err)
+		}
+		if n == nil {
+			return nil, NewNotFoundError("nippou", id.String())
+		}
+		nippous = []*domain.Nippou{n}
+		start, end = n.Date(), n.Date()
+
+	case input.Date != "":
+		if start, err = domain.ParseCivilDate(input.Date); err != nil {
+			return nil, NewInvalidInputError("date", "expected YYYY-MM-DD format")
+		}
+		end = start
+		if nippous, err = uc.reader.FindByDate(start); err != nil {
+			return nil, NewRepositoryReadError(err)
+		}
+
+	default:
+		if start, end, err = readRange(input); err != nil {
+			return nil, err
+		}
+		if nippous, err = uc.ranges.FindByDateRange(start, end); err != nil {
+			return nil, NewRepositoryReadError(err)
+		}
+	}
+
+	// Step 3: Order and map to output DTOs
+	sort.SliceStable(nippous, func(i, j int) bool {
+		if c := nippous[i].Date().Compare(nippous[j].Date()); c != 0 {
+			return c < 0
+		}
+		return nippous[i].CreatedAt().Before(nippous[j].CreatedAt())
+	})
+	output := &ReadOutput{
+		StartDate: start.String(),
+		EndDate:   end.String(),
+		Nippous:   make([]*CreateOutput, 0, len(nippous)),
+	}
+	for _, n := range nippous {
+		if n != nil {
+			output.Nippous = append(output.Nippous, mapToOutput(n))
+		}
+	}
+	return output, nil
+}
+
+// readRange resolves a week or explicit range to inclusive dates.
+func readRange(input *ReadInput) (domain.CivilDate, domain.CivilDate, error) {
+	if input.Week == "" {
+		return parseDateRange(input.StartDate, input.EndDate)
+	}
+	monday, err := domain.ParseISOWeek(input.Week)
+	if err != nil {
+		return domain.CivilDate{}, domain.CivilDate{}, NewInvalidInputError("week", "expected YYYY-Www format")
+	}
+	return monday, monday.AddDays(6), nil
+}
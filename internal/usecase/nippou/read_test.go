@@ -0,0 +1,125 @@
+package nippou
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// ReadInput Tests
+// ============================================================================
+
+func TestReadInput_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *ReadInput
+		wantErr bool
+	}{
+		{"id", &ReadInput{ID: "x"}, false},
+		{"date", &ReadInput{Date: "2024-01-15"}, false},
+		{"week", &ReadInput{Week: "2024-W03"}, false},
+		{"range", &ReadInput{StartDate: "2024-01-15", EndDate: "2024-01-19"}, false},
+		{"nothing selected", &ReadInput{}, true},
+		{"two selectors", &ReadInput{Date: "2024-01-15", Week: "2024-W03"}, true},
+		{"nil", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// ReadUseCase Tests
+// ============================================================================
+
+func TestNewReadUseCase_NilRepository(t *testing.T) {
+	if _, err := NewReadUseCase(nil, &MockDateRangeReader{}); !errors.Is(err, ErrRepositoryNil) {
+		t.Errorf("NewReadUseCase(nil, ...) error = %v", err)
+	}
+	if _, err := NewReadUseCase(&MockRepository{}, nil); !errors.Is(err, ErrRepositoryNil) {
+		t.Errorf("NewReadUseCase(..., nil) error = %v", err)
+	}
+}
+
+func TestReadUseCase_ByID(t *testing.T) {
+	repo, n := storedNippou(t)
+	uc, _ := NewReadUseCase(repo, &MockDateRangeReader{})
+
+	output, err := uc.Execute(context.Background(), &ReadInput{ID: n.ID().String()})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(output.Nippous) != 1 || output.Nippous[0].ID != n.ID().String() || output.StartDate != "2024-01-15" {
+		t.Errorf("output = %+v", output)
+	}
+
+	_, err = uc.Execute(context.Background(), &ReadInput{ID: domain.NewID().String()})
+	var ucErr *UseCaseError
+	if !errors.As(err, &ucErr) || ucErr.Code != ErrCodeNotFound {
+		t.Errorf("unknown ID error = %v, want NOT_FOUND", err)
+	}
+}
+
+func TestReadUseCase_ByWeekSortsReports(t *testing.T) {
+	ranges := &MockDateRangeReader{FindByDateRangeFunc: func(start, end domain.CivilDate) ([]*domain.Nippou, error) {
+		return []*domain.Nippou{
+			summaryFixture(t, "2024-01-17", "水曜", nil, nil, nil),
+			summaryFixture(t, "2024-01-15", "月曜", nil, nil, nil),
+		}, nil
+	}}
+	uc, _ := NewReadUseCase(&MockRepository{}, ranges)
+
+	output, err := uc.Execute(context.Background(), &ReadInput{Week: "2024-W03"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if ranges.LastStart.String() != "2024-01-15" || ranges.LastEnd.String() != "2024-01-21" {
+		t.Errorf("queried %s..%s, want 2024-01-15..2024-01-21", ranges.LastStart, ranges.LastEnd)
+	}
+	if output.StartDate != "2024-01-15" || output.EndDate != "2024-01-21" || len(output.Nippous) != 2 ||
+		output.Nippous[0].Date != "2024-01-15" || output.Nippous[1].Date != "2024-01-17" {
+		t.Errorf("output = %+v", output)
+	}
+}
+
+func TestReadUseCase_InvalidInput(t *testing.T) {
+	uc, _ := NewReadUseCase(&MockRepository{}, &MockDateRangeReader{})
+	tests := []struct {
+		name  string
+		input *ReadInput
+		field string
+	}{
+		{"bad date", &ReadInput{Date: "15/01/2024"}, "date"},
+		{"bad week", &ReadInput{Week: "2024-W54"}, "week"},
+		{"reversed range", &ReadInput{StartDate: "2024-01-19", EndDate: "2024-01-15"}, "endDate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(context.Background(), tt.input)
+			var ucErr *UseCaseError
+			if !errors.As(err, &ucErr) || ucErr.Code != ErrCodeInvalidInput || ucErr.Field != tt.field {
+				t.Errorf("Execute() error = %v, want INVALID_INPUT on %s", err, tt.field)
+			}
+		})
+	}
+}
+
+func TestReadUseCase_RepositoryError(t *testing.T) {
+	repo := &MockRepository{FindByDateFunc: func(date domain.CivilDate) ([]*domain.Nippou, error) {
+		return nil, errors.New("connection refused")
+	}}
+	uc, _ := NewReadUseCase(repo, &MockDateRangeReader{})
+
+	_, err := uc.Execute(context.Background(), &ReadInput{Date: "2024-01-15"})
+	var ucErr *UseCaseError
+	if !errors.As(err, &ucErr) || ucErr.Code != ErrCodeRepositoryError {
+		t.Errorf("Execute() error = %v, want REPOSITORY_ERROR", err)
+	}
+}
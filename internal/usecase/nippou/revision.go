@@ -0,0 +1,306 @@
+package nippou
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Update UseCase - Edits With Revision Log
+// ============================================================================
+
+// UpdateUseCase edits the content, tags or location of a Nippou and records
+// every change in the revision log.
+type UpdateUseCase struct {
+	repo      domain.Repository
+	revisions domain.RevisionRepository
+	events    domain.EventDispatcher
+}
+
+// NewUpdateUseCase creates a new UpdateUseCase.
+func NewUpdateUseCase(repo domain.Repository, revisions domain.RevisionRepository) (*UpdateUseCase, error) {
+	if repo == nil || revisions == nil {
+		return nil, ErrRepositoryNil
+	}
+	return &UpdateUseCase{repo: repo, revisions: revisions}, nil
+}
+
+// WithEventDispatcher sets the dispatcher that receives the domain events of
+// each saved edit.
+func (uc *UpdateUseCase) WithEventDispatcher(d domain.EventDispatcher) *UpdateUseCase {
+	uc.events = d
+	return uc
+}
+
+// Execute applies the edit. The revisions are saved before the Nippou, so an
+// edit is never stored without its audit trail: when logging fails nothing
+// is saved and a retry records the same changes again. Events are dispatched
+// only after both writes succeed. An edit that changes nothing is not saved
+// and records no revisions.
+func (uc *UpdateUseCase) Execute(ctx context.Context, input *UpdateInput) (*UpdateOutput, error) {
+	// Guard: Check context
+	if ctx == nil {
+		return nil, ErrContextNil
+	}
+
+	// Step 1: Validate input DTO
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	id, err := domain.IDFromString(input.ID)
+	if err != nil {
+		return nil, NewDomainViolationError(err)
+	}
+
+	// Check for context cancellation before repository operations
+	select {
+	case <-ctx.Done():
+		return nil, &UseCaseError{
+			Code:    ErrCodeContextCancelled,
+			Message: "operation cancelled",
+			Cause:   ctx.Err(),
+		}
+	default:
+	}
+
+	// Step 2: Load the Nippou
+	n, err := uc.repo.FindByID(id)
+	if err != nil {
+		return nil, NewRepositoryReadError(err)
+	}
+	if n == nil {
+		return nil, NewNotFoundError("nippou", id.String())
+	}
+	n.ClearChanges()
+	n.PullEvents()
+
+	// Step 3: Apply the edits; the entity tracks what actually changed
+	if err := applyUpdate(n, input); err != nil {
+		return nil, NewDomainViolationError(err)
+	}
+	changes := n.Changes()
+	output := &UpdateOutput{Nippou: mapToOutput(n), Revisions: []RevisionOutput{}}
+	if len(changes) == 0 {
+		n.PullEvents()
+		return output, nil
+	}
+
+	revisions := make([]*domain.Revision, 0, len(changes))
+	for _, change := range changes {
+		rev, err := domain.NewRevision(n.ID(), input.Author, change)
+		if err != nil {
+			return nil, NewDomainViolationError(err)
+		}
+		revisions = append(revisions, rev)
+	}
+
+	// Step 4: Persist the revisions, then the Nippou, then announce the edit
+	if err := uc.revisions.SaveRevisions(revisions); err != nil {
+		return nil, NewRepositoryError(err)
+	}
+	if err := uc.repo.Save(n); err != nil {
+		return nil, NewRepositoryError(err)
+	}
+	n.ClearChanges()
+	dispatchEvents(ctx, uc.events, n)
+
+	for _, rev := range revisions {
+		output.Revisions = append(output.Revisions, mapRevisionToOutput(0, rev))
+	}
+	return output, nil
+}
+
+// applyUpdate applies the requested edits to n.
+func applyUpdate(n *domain.Nippou, input *UpdateInput) error {
+	if input.Content != nil {
+		if err := n.UpdateContent(*input.Content); err != nil {
+			return err
+		}
+	}
+
+	if input.Tags != nil {
+		wanted := make([]domain.Tag, 0, len(input.Tags))
+		for _, s := range input.Tags {
+			// A stored tag that is now reserved may be kept, not newly added
+			tag, err := domain.ParseTag(s)
+			if err != nil {
+				return err
+			}
+			if domain.IsReservedTag(tag.String()) && !n.HasTag(tag.String()) {
+				return domain.ErrReservedTag
+			}
+			for _, w := range wanted {
+				if w.Equals(tag) {
+					return domain.ErrDuplicateTag
+				}
+			}
+			wanted = append(wanted, tag)
+		}
+		for _, existing := range n.Tags() {
+			if !containsTag(wanted, existing) {
+				if err := n.RemoveTag(existing.String()); err != nil {
+					return err
+				}
+			}
+		}
+		for _, tag := range wanted {
+			if !containsTag(n.Tags(), tag) {
+				if err := n.AddValidatedTag(tag); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	switch {
+	case input.RemoveLocation:
+		return n.RemoveLocation()
+	case input.Location != nil:
+		return n.AttachLocation(input.Location.Latitude, input.Location.Longitude, input.Location.Address)
+	}
+	return nil
+}
+
+// containsTag reports whether tags contains tag.
+func containsTag(tags []domain.Tag, tag domain.Tag) bool {
+	for _, t := range tags {
+		if t.Equals(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// ============================================================================
+// History UseCase - Revision Log and Diff
+// ============================================================================
+
+// HistoryUseCase lists the revisions of one field of a Nippou and diffs any
+// two of them.
+type HistoryUseCase struct {
+	revisions domain.RevisionRepository
+}
+
+// NewHistoryUseCase creates a new HistoryUseCase.
+func NewHistoryUseCase(revisions domain.RevisionRepository) (*HistoryUseCase, error) {
+	if revisions == nil {
+		return nil, ErrRepositoryNil
+	}
+	return &HistoryUseCase{revisions: revisions}, nil
+}
+
+// Execute loads the history and, when requested, the diff.
+func (uc *HistoryUseCase) Execute(ctx context.Context, input *HistoryInput) (*HistoryOutput, error) {
+	// Guard: Check context
+	if ctx == nil {
+		return nil, ErrContextNil
+	}
+
+	// Step 1: Validate input DTO
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	nippouID, err := domain.IDFromString(input.NippouID)
+	if err != nil {
+		return nil, NewDomainViolationError(err)
+	}
+	field := domain.RevisionContent
+	if input.Field != "" {
+		field = domain.RevisionField(input.Field)
+	}
+
+	// Check for context cancellation before repository operation
+	select {
+	case <-ctx.Done():
+		return nil, &UseCaseError{
+			Code:    ErrCodeContextCancelled,
+			Message: "operation cancelled",
+			Cause:   ctx.Err(),
+		}
+	default:
+	}
+
+	// Step 2: Load the revisions of the requested field
+	all, err := uc.revisions.FindRevisions(nippouID)
+	if err != nil {
+		return nil, NewRepositoryReadError(err)
+	}
+	var revisions []*domain.Revision
+	for _, rev := range all {
+		if rev != nil && rev.Field() == field {
+			revisions = append(revisions, rev)
+		}
+	}
+
+	output := &HistoryOutput{
+		NippouID:  nippouID.String(),
+		Field:     string(field),
+		Revisions: make([]RevisionOutput, 0, len(revisions)),
+	}
+	for i, rev := range revisions {
+		output.Revisions = append(output.Revisions, mapRevisionToOutput(i+1, rev))
+	}
+
+	// Step 3: Diff two revisions when requested
+	if input.From == nil && input.To == nil {
+		return output, nil
+	}
+	from, to := 0, len(revisions)
+	if input.From != nil {
+		from = *input.From
+	}
+	if input.To != nil {
+		to = *input.To
+	}
+	if len(revisions) == 0 {
+		return nil, NewInvalidInputError("from", "there are no revisions to compare")
+	}
+	if from < 0 || from > len(revisions) {
+		return nil, NewInvalidInputError("from", fmt.Sprintf("must be between 0 and %d", len(revisions)))
+	}
+	if to < 0 || to > len(revisions) {
+		return nil, NewInvalidInputError("to", fmt.Sprintf("must be between 0 and %d", len(revisions)))
+	}
+	output.Diff = diffRevisions(revisions, from, to)
+	return output, nil
+}
+
+// revisionValue returns the field value at revision number (0 is the value
+// before the first revision).
+func revisionValue(revisions []*domain.Revision, number int) string {
+	if number == 0 {
+		return revisions[0].Previous()
+	}
+	return revisions[number-1].Current()
+}
+
+// diffRevisions diffs the values at revision numbers from and to.
+func diffRevisions(revisions []*domain.Revision, from, to int) *DiffOutput {
+	diff := &DiffOutput{From: from, To: to, Lines: []string{}}
+	for _, line := range domain.DiffLines(revisionValue(revisions, from), revisionValue(revisions, to)) {
+		switch line.Op {
+		case domain.DiffInsert:
+			diff.Added++
+		case domain.DiffDelete:
+			diff.Removed++
+		}
+		diff.Lines = append(diff.Lines, line.String())
+	}
+	return diff
+}
+
+// mapRevisionToOutput converts a domain Revision to the output DTO.
+func mapRevisionToOutput(number int, rev *domain.Revision) RevisionOutput {
+	return RevisionOutput{
+		Number:    number,
+		ID:        rev.ID().String(),
+		Field:     string(rev.Field()),
+		Author:    rev.Author(),
+		ChangedAt: rev.ChangedAt().UTC().Format(time.RFC3339),
+		Previous:  rev.Previous(),
+		Current:   rev.Current(),
+	}
+}
@@ -0,0 +1,370 @@
+package nippou
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Test Doubles
+// ============================================================================
+
+// MockRevisionRepository is an in-memory test double for domain.RevisionRepository.
+type MockRevisionRepository struct {
+	Revisions []*domain.Revision
+	SaveErr   error
+	FindErr   error
+}
+
+func (m *MockRevisionRepository) SaveRevisions(revisions []*domain.Revision) error {
+	if m.SaveErr != nil {
+		return m.SaveErr
+	}
+	m.Revisions = append(m.Revisions, revisions...)
+	return nil
+}
+
+func (m *MockRevisionRepository) FindRevisions(nippouID domain.ID) ([]*domain.Revision, error) {
+	if m.FindErr != nil {
+		return nil, m.FindErr
+	}
+	var result []*domain.Revision
+	for _, r := range m.Revisions {
+		if r.NippouID().Equals(nippouID) {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+// storedNippou returns a repository holding a single tagged Nippou.
+func storedNippou(t *testing.T) (*MockRepository, *domain.Nippou) {
+	t.Helper()
+	n, err := domain.NewNippou("2024-01-15", "line 1\nline 2")
+	if err != nil {
+		t.Fatalf("NewNippou() error = %v", err)
+	}
+	if err := n.AddTag("sales"); err != nil {
+		t.Fatalf("AddTag() error = %v", err)
+	}
+	n.ClearChanges()
+	repo := &MockRepository{FindByIDFunc: func(id domain.ID) (*domain.Nippou, error) {
+		if id.Equals(n.ID()) {
+			return n, nil
+		}
+		return nil, nil
+	}}
+	return repo, n
+}
+
+func stringPtr(s string) *string { return &s }
+
+func intPtr(i int) *int { return &i }
+
+// ============================================================================
+// Update UseCase Tests
+// ============================================================================
+
+func TestUpdateUseCase_RecordsRevisions(t *testing.T) {
+	repo, n := storedNippou(t)
+	revisions := &MockRevisionRepository{}
+	uc, _ := NewUpdateUseCase(repo, revisions)
+
+	output, err := uc.Execute(context.Background(), &UpdateInput{
+		ID:       n.ID().String(),
+		Author:   "佐藤",
+		Content:  stringPtr("line 1\nline 2 edited"),
+		Tags:     []string{"sales", "visit"},
+		Location: &LocationInput{Latitude: 35.6812, Longitude: 139.7671, Address: "Tokyo"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if repo.SaveCalled != 1 {
+		t.Errorf("SaveCalled = %d, want 1", repo.SaveCalled)
+	}
+	if len(output.Revisions) != 3 || len(revisions.Revisions) != 3 {
+		t.Fatalf("revisions = %+v, want 3", output.Revisions)
+	}
+
+	want := map[string][2]string{
+		"content":  {"line 1\nline 2", "line 1\nline 2 edited"},
+		"tags":     {"sales", "sales\nvisit"},
+		"location": {"", "35.6812000,139.7671000 Tokyo"},
+	}
+	for _, rev := range output.Revisions {
+		w, ok := want[rev.Field]
+		if !ok || rev.Previous != w[0] || rev.Current != w[1] {
+			t.Errorf("revision %s = %q -> %q, want %q -> %q", rev.Field, rev.Previous, rev.Current, w[0], w[1])
+		}
+		if rev.Author != "佐藤" || rev.ChangedAt == "" {
+			t.Errorf("revision %s author/time = %q, %q", rev.Field, rev.Author, rev.ChangedAt)
+		}
+	}
+	if len(n.Changes()) != 0 {
+		t.Errorf("Changes() = %v, want cleared after save", n.Changes())
+	}
+}
+
+func TestUpdateUseCase_NoOpEdit(t *testing.T) {
+	repo, n := storedNippou(t)
+	revisions := &MockRevisionRepository{}
+	uc, _ := NewUpdateUseCase(repo, revisions)
+
+	output, err := uc.Execute(context.Background(), &UpdateInput{
+		ID:      n.ID().String(),
+		Author:  "佐藤",
+		Content: stringPtr("line 1\nline 2"),
+		Tags:    []string{"Sales"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if repo.SaveCalled != 0 || len(revisions.Revisions) != 0 || len(output.Revisions) != 0 {
+		t.Errorf("no-op edit saved: SaveCalled=%d revisions=%d", repo.SaveCalled, len(revisions.Revisions))
+	}
+}
+
+func TestUpdateUseCase_ReplacesTagsAroundReservedTag(t *testing.T) {
+	domain.SetReservedTags("all")
+	defer domain.SetReservedTags()
+
+	n, err := domain.Reconstruct(domain.ReconstructedNippou{
+		ID:      domain.NewID().String(),
+		Date:    domain.CivilDateOf(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)),
+		Content: "report",
+		Tags:    []string{"all", "sales"},
+	})
+	if err != nil {
+		t.Fatalf("Reconstruct() error = %v", err)
+	}
+	repo := &MockRepository{FindByIDFunc: func(id domain.ID) (*domain.Nippou, error) { return n, nil }}
+	uc, _ := NewUpdateUseCase(repo, &MockRevisionRepository{})
+
+	if _, err := uc.Execute(context.Background(), &UpdateInput{ID: n.ID().String(), Author: "佐藤", Tags: []string{"all", "visit"}}); err != nil {
+		t.Fatalf("Execute(keep reserved) error = %v", err)
+	}
+	if _, err := uc.Execute(context.Background(), &UpdateInput{ID: n.ID().String(), Author: "佐藤", Tags: []string{"visit"}}); err != nil {
+		t.Fatalf("Execute(drop reserved) error = %v", err)
+	}
+	if got := n.TagStrings(); len(got) != 1 || got[0] != "visit" {
+		t.Errorf("tags = %v, want [visit]", got)
+	}
+	_, err = uc.Execute(context.Background(), &UpdateInput{ID: n.ID().String(), Author: "佐藤", Tags: []string{"all"}})
+	if !errors.Is(err, domain.ErrReservedTag) {
+		t.Errorf("Execute(add reserved) error = %v, want ErrReservedTag", err)
+	}
+}
+
+func TestUpdateUseCase_Errors(t *testing.T) {
+	repo, n := storedNippou(t)
+	uc, _ := NewUpdateUseCase(repo, &MockRevisionRepository{})
+	failing, _ := NewUpdateUseCase(repo, &MockRevisionRepository{SaveErr: errors.New("timeout")})
+
+	tests := []struct {
+		name  string
+		uc    *UpdateUseCase
+		input *UpdateInput
+		code  string
+	}{
+		{"nil input", uc, nil, ErrCodeInvalidInput},
+		{"no changes", uc, &UpdateInput{ID: n.ID().String(), Author: "a"}, ErrCodeInvalidInput},
+		{"missing author", uc, &UpdateInput{ID: n.ID().String(), Content: stringPtr("x")}, ErrCodeInvalidInput},
+		{"unknown nippou", uc, &UpdateInput{ID: domain.NewID().String(), Author: "a", Content: stringPtr("x")}, ErrCodeNotFound},
+		{"empty content", uc, &UpdateInput{ID: n.ID().String(), Author: "a", Content: stringPtr(" ")}, ErrCodeDomainViolation},
+		{"revision save failure", failing, &UpdateInput{ID: n.ID().String(), Author: "a", Content: stringPtr("new")}, ErrCodeRepositoryError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.uc.Execute(context.Background(), tt.input)
+			var ucErr *UseCaseError
+			if !errors.As(err, &ucErr) || ucErr.Code != tt.code {
+				t.Errorf("error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// History UseCase Tests
+// ============================================================================
+
+func contentHistory(t *testing.T, nippouID domain.ID, values ...string) *MockRevisionRepository {
+	t.Helper()
+	repo := &MockRevisionRepository{}
+	at := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	for i := 1; i < len(values); i++ {
+		rev, err := domain.NewRevision(nippouID, "佐藤", domain.Change{
+			Field:     domain.RevisionContent,
+			Previous:  values[i-1],
+			Current:   values[i],
+			ChangedAt: at.Add(time.Duration(i) * time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("NewRevision() error = %v", err)
+		}
+		repo.Revisions = append(repo.Revisions, rev)
+	}
+	return repo
+}
+
+func TestHistoryUseCase_ListAndDiff(t *testing.T) {
+	nippouID := domain.NewID()
+	uc, _ := NewHistoryUseCase(contentHistory(t, nippouID, "a\nb\nc", "a\nB\nc", "a\nB\nc\nd"))
+
+	list, err := uc.Execute(context.Background(), &HistoryInput{NippouID: nippouID.String()})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if list.Field != "content" || len(list.Revisions) != 2 || list.Diff != nil {
+		t.Fatalf("unexpected history: %+v", list)
+	}
+	if list.Revisions[0].Number != 1 || list.Revisions[1].Number != 2 {
+		t.Errorf("numbers = %d, %d, want 1, 2", list.Revisions[0].Number, list.Revisions[1].Number)
+	}
+
+	tests := []struct {
+		name           string
+		from, to       *int
+		wantLines      []string
+		added, removed int
+	}{
+		{"explicit range", intPtr(0), intPtr(2), []string{"  a", "- b", "+ B", "  c", "+ d"}, 2, 1},
+		{"from only", intPtr(1), nil, []string{"  a", "  B", "  c", "+ d"}, 1, 0},
+		{"reverse", intPtr(2), intPtr(1), []string{"  a", "  B", "  c", "- d"}, 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(context.Background(), &HistoryInput{NippouID: nippouID.String(), From: tt.from, To: tt.to})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			d := output.Diff
+			if d == nil || d.Added != tt.added || d.Removed != tt.removed {
+				t.Fatalf("Diff = %+v, want +%d -%d", d, tt.added, tt.removed)
+			}
+			if len(d.Lines) != len(tt.wantLines) {
+				t.Fatalf("Lines = %q, want %q", d.Lines, tt.wantLines)
+			}
+			for i := range d.Lines {
+				if d.Lines[i] != tt.wantLines[i] {
+					t.Errorf("Lines[%d] = %q, want %q", i, d.Lines[i], tt.wantLines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestHistoryUseCase_Errors(t *testing.T) {
+	nippouID := domain.NewID()
+	uc, _ := NewHistoryUseCase(contentHistory(t, nippouID, "a", "b"))
+	empty, _ := NewHistoryUseCase(&MockRevisionRepository{})
+	failing, _ := NewHistoryUseCase(&MockRevisionRepository{FindErr: errors.New("timeout")})
+
+	tests := []struct {
+		name  string
+		uc    *HistoryUseCase
+		input *HistoryInput
+		code  string
+	}{
+		{"nil input", uc, nil, ErrCodeInvalidInput},
+		{"unknown field", uc, &HistoryInput{NippouID: nippouID.String(), Field: "title"}, ErrCodeInvalidInput},
+		{"out of range", uc, &HistoryInput{NippouID: nippouID.String(), To: intPtr(2)}, ErrCodeInvalidInput},
+		{"negative", uc, &HistoryInput{NippouID: nippouID.String(), From: intPtr(-1)}, ErrCodeInvalidInput},
+		{"no revisions", empty, &HistoryInput{NippouID: nippouID.String(), From: intPtr(0)}, ErrCodeInvalidInput},
+		{"load failure", failing, &HistoryInput{NippouID: nippouID.String()}, ErrCodeRepositoryError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.uc.Execute(context.Background(), tt.input)
+			var ucErr *UseCaseError
+			if !errors.As(err, &ucErr) || ucErr.Code != tt.code {
+				t.Errorf("error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestNewRevisionUseCases_NilRepository(t *testing.T) {
+	if _, err := NewUpdateUseCase(&MockRepository{}, nil); err != ErrRepositoryNil {
+		t.Errorf("NewUpdateUseCase() error = %v, want ErrRepositoryNil", err)
+	}
+	if _, err := NewHistoryUseCase(nil); err != ErrRepositoryNil {
+		t.Errorf("NewHistoryUseCase() error = %v, want ErrRepositoryNil", err)
+	}
+}
+
+func TestUpdateUseCase_DispatchesEvents(t *testing.T) {
+	repo, n := storedNippou(t)
+	n.PullEvents()
+	dispatcher := &MockEventDispatcher{}
+	uc, _ := NewUpdateUseCase(repo, &MockRevisionRepository{})
+	uc.WithEventDispatcher(dispatcher)
+
+	if _, err := uc.Execute(context.Background(), &UpdateInput{
+		ID:      n.ID().String(),
+		Author:  "佐藤",
+		Content: stringPtr("revised"),
+		Tags:    []string{"visit"},
+	}); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	want := []string{domain.EventContentUpdated, domain.EventTagRemoved, domain.EventTagAdded}
+	if len(dispatcher.Events) != len(want) {
+		t.Fatalf("events = %v, want %v", dispatcher.Events, want)
+	}
+	for i, e := range dispatcher.Events {
+		if e.EventName() != want[i] {
+			t.Errorf("events[%d] = %s, want %s", i, e.EventName(), want[i])
+		}
+	}
+}
+
+func TestUpdateUseCase_RevisionFailureSavesNothing(t *testing.T) {
+	repo, n := storedNippou(t)
+	n.PullEvents()
+	dispatcher := &MockEventDispatcher{}
+	uc, _ := NewUpdateUseCase(repo, &MockRevisionRepository{SaveErr: errors.New("timeout")})
+	uc.WithEventDispatcher(dispatcher)
+
+	_, err := uc.Execute(context.Background(), &UpdateInput{
+		ID:      n.ID().String(),
+		Author:  "佐藤",
+		Content: stringPtr("revised"),
+	})
+	var ucErr *UseCaseError
+	if !errors.As(err, &ucErr) || ucErr.Code != ErrCodeRepositoryError {
+		t.Fatalf("Execute() error = %v, want REPOSITORY_ERROR", err)
+	}
+	if repo.SaveCalled != 0 {
+		t.Errorf("Save called %d times, want 0 when revisions fail", repo.SaveCalled)
+	}
+	if len(dispatcher.Events) != 0 {
+		t.Errorf("events = %v, want none when revisions fail", dispatcher.Events)
+	}
+}
+
+func TestUpdateUseCase_SaveFailureDispatchesNothing(t *testing.T) {
+	repo, n := storedNippou(t)
+	n.PullEvents()
+	repo.SaveFunc = func(*domain.Nippou) error { return errors.New("timeout") }
+	dispatcher := &MockEventDispatcher{}
+	uc, _ := NewUpdateUseCase(repo, &MockRevisionRepository{})
+	uc.WithEventDispatcher(dispatcher)
+
+	if _, err := uc.Execute(context.Background(), &UpdateInput{
+		ID:      n.ID().String(),
+		Author:  "佐藤",
+		Content: stringPtr("revised"),
+	}); err == nil {
+		t.Fatal("Execute() should fail when the Nippou cannot be saved")
+	}
+	if len(dispatcher.Events) != 0 {
+		t.Errorf("events = %v, want none when the save fails", dispatcher.Events)
+	}
+}
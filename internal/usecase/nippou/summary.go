@@ -0,0 +1,339 @@
+package nippou
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"salesforce-mcp-server/internal/domain/calendar"
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Summary UseCase - Period Aggregation
+// ============================================================================
+
+// SummaryUseCase aggregates the reports of a week, month or custom range into
+// statistics and a Markdown digest for managers writing period summaries.
+type SummaryUseCase struct {
+	repo     domain.DateRangeReader
+	calendar *calendar.BusinessCalendar
+	location *time.Location
+	timeFunc func() time.Time
+}
+
+// NewSummaryUseCase creates a new SummaryUseCase with the given repository.
+func NewSummaryUseCase(repo domain.DateRangeReader) (*SummaryUseCase, error) {
+	if repo == nil {
+		return nil, ErrRepositoryNil
+	}
+	return &SummaryUseCase{
+		repo:     repo,
+		calendar: calendar.NewBusinessCalendar(nil),
+		location: domain.DefaultLocation(),
+		timeFunc: time.Now,
+	}, nil
+}
+
+// WithCalendar sets the business calendar used to find days without a report.
+func (uc *SummaryUseCase) WithCalendar(cal *calendar.BusinessCalendar) *SummaryUseCase {
+	uc.calendar = cal
+	return uc
+}
+
+// WithLocation sets the user's time zone, which decides what "today" is.
+func (uc *SummaryUseCase) WithLocation(loc *time.Location) *SummaryUseCase {
+	uc.location = loc
+	return uc
+}
+
+// WithTimeFunc sets a custom time function (useful for testing).
+// Days after "today" are never reported as missing.
+func (uc *SummaryUseCase) WithTimeFunc(fn func() time.Time) *SummaryUseCase {
+	uc.timeFunc = fn
+	return uc
+}
+
+// Execute loads the reports in the requested period and summarizes them.
+func (uc *SummaryUseCase) Execute(ctx context.Context, input *SummaryInput) (*SummaryOutput, error) {
+	// Guard: Check context
+	if ctx == nil {
+		return nil, ErrContextNil
+	}
+
+	// Step 1: Validate input DTO
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Step 2: Resolve the period to an inclusive date range
+	start, end, err := resolveSummaryRange(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for context cancellation before repository operation
+	select {
+	case <-ctx.Done():
+		return nil, &UseCaseError{
+			Code:    ErrCodeContextCancelled,
+			Message: "operation cancelled",
+			Cause:   ctx.Err(),
+		}
+	default:
+	}
+
+	// Step 3: Load reports
+	nippous, err := uc.repo.FindByDateRange(start, end)
+	if err != nil {
+		return nil, NewRepositoryReadError(err)
+	}
+
+	// Step 4: Aggregate and render
+	output := summarize(input.Period, start, end, nippous)
+	output.MissingDates = missingBusinessDays(uc.calendar, start, end, domain.TodayIn(uc.timeFunc(), uc.location), output.Entries)
+	output.DaysWithoutReport = len(output.MissingDates)
+	output.Markdown = RenderSummaryMarkdown(output)
+	return output, nil
+}
+
+// resolveSummaryRange returns the first and last day covered by input.
+func resolveSummaryRange(input *SummaryInput) (domain.CivilDate, domain.CivilDate, error) {
+	if input.Period == SummaryPeriodCustom {
+		return parseDateRange(input.StartDate, input.EndDate)
+	}
+
+	anchor, err := domain.ParseCivilDate(input.Date)
+	if err != nil {
+		return domain.CivilDate{}, domain.CivilDate{}, NewInvalidInputError("date", "expected YYYY-MM-DD format")
+	}
+	if input.Period == SummaryPeriodMonth {
+		start := anchor.AddDays(1 - anchor.Day())
+		return start, start.AddMonths(1).AddDays(-1), nil
+	}
+	// Weeks start on Monday
+	start := anchor.AddDays(-((int(anchor.Weekday()) + 6) % 7))
+	return start, start.AddDays(6), nil
+}
+
+// parseDateRange parses an inclusive YYYY-MM-DD range of at most MaxSummaryDays.
+func parseDateRange(startDate, endDate string) (domain.CivilDate, domain.CivilDate, error) {
+	start, err := domain.ParseCivilDate(startDate)
+	if err != nil {
+		return domain.CivilDate{}, domain.CivilDate{}, NewInvalidInputError("startDate", "expected YYYY-MM-DD format")
+	}
+	end, err := domain.ParseCivilDate(endDate)
+	if err != nil {
+		return domain.CivilDate{}, domain.CivilDate{}, NewInvalidInputError("endDate", "expected YYYY-MM-DD format")
+	}
+	if end.Before(start) {
+		return domain.CivilDate{}, domain.CivilDate{}, NewInvalidInputError("endDate", "must not be before startDate")
+	}
+	if days := start.DaysUntil(end) + 1; days > MaxSummaryDays {
+		return domain.CivilDate{}, domain.CivilDate{}, NewInvalidInputError("endDate", fmt.Sprintf("range exceeds %d days", MaxSummaryDays))
+	}
+	return start, end, nil
+}
+
+// ============================================================================
+// Aggregation
+// ============================================================================
+
+// summarize computes the statistics for nippous within start..end.
+// Missing days are filled in separately by missingBusinessDays.
+func summarize(period SummaryPeriod, start, end domain.CivilDate, nippous []*domain.Nippou) *SummaryOutput {
+	output := &SummaryOutput{
+		Period:         period,
+		StartDate:      start.String(),
+		EndDate:        end.String(),
+		MissingDates:   []string{},
+		TagFrequencies: []TagFrequency{},
+		Locations:      []LocationVisit{},
+		Voice:          VoiceUsage{Models: []ModelUsage{}},
+		Entries:        []SummaryEntry{},
+	}
+
+	sorted := make([]*domain.Nippou, 0, len(nippous))
+	for _, n := range nippous {
+		if n != nil {
+			sorted = append(sorted, n)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if c := sorted[i].Date().Compare(sorted[j].Date()); c != 0 {
+			return c < 0
+		}
+		return sorted[i].CreatedAt().Before(sorted[j].CreatedAt())
+	})
+
+	reportedDays := make(map[string]bool)
+	tagCounts := make(map[string]int)
+	locationIndex := make(map[string]int)
+	modelCounts := make(map[string]int)
+
+	for _, n := range sorted {
+		date := n.Date().String()
+		output.ReportCount++
+		reportedDays[date] = true
+
+		tags := n.TagStrings()
+		if tags == nil {
+			tags = []string{}
+		}
+		for _, tag := range tags {
+			tagCounts[tag]++
+		}
+
+		if loc := n.Location(); loc != nil {
+			key := locationKey(loc)
+			if idx, ok := locationIndex[key]; ok {
+				output.Locations[idx].Count++
+			} else {
+				locationIndex[key] = len(output.Locations)
+				output.Locations = append(output.Locations, LocationVisit{
+					Address:   loc.Address(),
+					Latitude:  loc.Latitude(),
+					Longitude: loc.Longitude(),
+					Count:     1,
+				})
+			}
+		}
+
+		if voice := n.Voice(); voice != nil && voice.Enabled() {
+			output.Voice.Reports++
+			modelCounts[voice.ModelName()]++
+		}
+
+		output.Entries = append(output.Entries, SummaryEntry{
+			ID:      n.ID().String(),
+			Date:    date,
+			Content: n.Content(),
+			Tags:    tags,
+		})
+	}
+	output.DaysReported = len(reportedDays)
+
+	for tag, count := range tagCounts {
+		output.TagFrequencies = append(output.TagFrequencies, TagFrequency{Tag: tag, Count: count})
+	}
+	sort.Slice(output.TagFrequencies, func(i, j int) bool {
+		a, b := output.TagFrequencies[i], output.TagFrequencies[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Tag < b.Tag
+	})
+
+	for model, count := range modelCounts {
+		output.Voice.Models = append(output.Voice.Models, ModelUsage{Model: model, Count: count})
+	}
+	sort.Slice(output.Voice.Models, func(i, j int) bool {
+		a, b := output.Voice.Models[i], output.Voice.Models[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Model < b.Model
+	})
+
+	return output
+}
+
+// locationKey identifies a distinct visit location: the address when present,
+// otherwise the coordinates rounded to about 10 meters.
+func locationKey(loc *domain.Location) string {
+	if addr := strings.TrimSpace(loc.Address()); addr != "" {
+		return "addr:" + addr
+	}
+	return fmt.Sprintf("geo:%.4f,%.4f", loc.Latitude(), loc.Longitude())
+}
+
+// missingBusinessDays returns the business days from start to end, up to and
+// including today, on which none of entries was filed.
+func missingBusinessDays(cal *calendar.BusinessCalendar, start, end, today domain.CivilDate, entries []SummaryEntry) []string {
+	reported := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		reported[e.Date] = true
+	}
+	if today.Before(end) {
+		end = today
+	}
+
+	missing := []string{}
+	for _, day := range cal.BusinessDays(start.In(time.UTC), end.In(time.UTC)) {
+		if date := day.Format("2006-01-02"); !reported[date] {
+			missing = append(missing, date)
+		}
+	}
+	return missing
+}
+
+// ============================================================================
+// Markdown Rendering
+// ============================================================================
+
+// summaryTitles maps periods to Markdown headings.
+var summaryTitles = map[SummaryPeriod]string{
+	SummaryPeriodWeek:   "Weekly Summary",
+	SummaryPeriodMonth:  "Monthly Summary",
+	SummaryPeriodCustom: "Summary",
+}
+
+// RenderSummaryMarkdown renders a summary as a Markdown document.
+func RenderSummaryMarkdown(s *SummaryOutput) string {
+	if s == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s: %s – %s\n\n", summaryTitles[s.Period], s.StartDate, s.EndDate)
+
+	fmt.Fprintf(&sb, "- Reports: %d\n", s.ReportCount)
+	fmt.Fprintf(&sb, "- Days reported: %d\n", s.DaysReported)
+	if s.DaysWithoutReport > 0 {
+		fmt.Fprintf(&sb, "- Days without a report: %d (%s)\n", s.DaysWithoutReport, strings.Join(s.MissingDates, ", "))
+	} else {
+		sb.WriteString("- Days without a report: 0\n")
+	}
+	if s.Voice.Reports > 0 {
+		models := make([]string, len(s.Voice.Models))
+		for i, m := range s.Voice.Models {
+			models[i] = fmt.Sprintf("%s ×%d", m.Model, m.Count)
+		}
+		fmt.Fprintf(&sb, "- Voice input: %d reports (%s)\n", s.Voice.Reports, strings.Join(models, ", "))
+	} else {
+		sb.WriteString("- Voice input: 0 reports\n")
+	}
+
+	if len(s.TagFrequencies) > 0 {
+		sb.WriteString("\n## Tags\n\n| Tag | Reports |\n| --- | ---: |\n")
+		for _, tf := range s.TagFrequencies {
+			fmt.Fprintf(&sb, "| %s | %d |\n", tf.Tag, tf.Count)
+		}
+	}
+
+	if len(s.Locations) > 0 {
+		sb.WriteString("\n## Visited Locations\n\n")
+		for _, loc := range s.Locations {
+			name := loc.Address
+			if name == "" {
+				name = fmt.Sprintf("%.4f, %.4f", loc.Latitude, loc.Longitude)
+			}
+			fmt.Fprintf(&sb, "- %s (%d)\n", name, loc.Count)
+		}
+	}
+
+	if len(s.Entries) > 0 {
+		sb.WriteString("\n## Reports\n")
+		for _, e := range s.Entries {
+			fmt.Fprintf(&sb, "\n### %s", e.Date)
+			if len(e.Tags) > 0 {
+				fmt.Fprintf(&sb, " [%s]", strings.Join(e.Tags, ", "))
+			}
+			fmt.Fprintf(&sb, "\n\n%s\n", e.Content)
+		}
+	}
+
+	return sb.String()
+}
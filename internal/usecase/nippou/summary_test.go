@@ -0,0 +1,262 @@
+package nippou
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domain "salesforce-mcp-server/internal/domain/nippou"
+)
+
+// ============================================================================
+// Test Doubles
+// ============================================================================
+
+// MockDateRangeReader is a test double for domain.DateRangeReader.
+type MockDateRangeReader struct {
+	FindByDateRangeFunc func(startDate, endDate domain.CivilDate) ([]*domain.Nippou, error)
+	LastStart           domain.CivilDate
+	LastEnd             domain.CivilDate
+}
+
+func (m *MockDateRangeReader) FindByDateRange(startDate, endDate domain.CivilDate) ([]*domain.Nippou, error) {
+	m.LastStart, m.LastEnd = startDate, endDate
+	if m.FindByDateRangeFunc != nil {
+		return m.FindByDateRangeFunc(startDate, endDate)
+	}
+	return nil, nil
+}
+
+// summaryFixture builds a report for summary tests.
+func summaryFixture(t *testing.T, date, content string, tags []string, loc *domain.Location, voice *domain.VoiceConfig) *domain.Nippou {
+	t.Helper()
+	builder := domain.NewNippouBuilder(date, content)
+	if len(tags) > 0 {
+		builder.WithTags(builderTags(t, tags))
+	}
+	if loc != nil {
+		builder.WithLocation(loc)
+	}
+	if voice != nil {
+		builder.WithVoice(voice)
+	}
+	n, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	return n
+}
+
+// builderTags converts tag strings to domain tags.
+func builderTags(t *testing.T, tags []string) []domain.Tag {
+	t.Helper()
+	result := make([]domain.Tag, 0, len(tags))
+	for _, s := range tags {
+		tag, err := domain.NewTag(s)
+		if err != nil {
+			t.Fatalf("NewTag(%q) error = %v", s, err)
+		}
+		result = append(result, tag)
+	}
+	return result
+}
+
+// ============================================================================
+// SummaryInput Validation Tests
+// ============================================================================
+
+func TestSummaryInput_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *SummaryInput
+		valid bool
+	}{
+		{"week", &SummaryInput{Period: SummaryPeriodWeek, Date: "2024-01-17"}, true},
+		{"custom", &SummaryInput{Period: SummaryPeriodCustom, StartDate: "2024-01-01", EndDate: "2024-01-31"}, true},
+		{"nil", nil, false},
+		{"unknown period", &SummaryInput{Period: "year", Date: "2024-01-17"}, false},
+		{"missing date", &SummaryInput{Period: SummaryPeriodMonth}, false},
+		{"missing end", &SummaryInput{Period: SummaryPeriodCustom, StartDate: "2024-01-01"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if (err == nil) != tt.valid {
+				t.Errorf("Validate() error = %v, valid = %v", err, tt.valid)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// SummaryUseCase Tests
+// ============================================================================
+
+func TestNewSummaryUseCase_NilRepository(t *testing.T) {
+	if _, err := NewSummaryUseCase(nil); err != ErrRepositoryNil {
+		t.Errorf("NewSummaryUseCase(nil) error = %v, want ErrRepositoryNil", err)
+	}
+}
+
+func TestSummaryUseCase_ResolvesPeriods(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *SummaryInput
+		wantStart string
+		wantEnd   string
+	}{
+		{"week from wednesday", &SummaryInput{Period: SummaryPeriodWeek, Date: "2024-01-17"}, "2024-01-15", "2024-01-21"},
+		{"week from sunday", &SummaryInput{Period: SummaryPeriodWeek, Date: "2024-01-21"}, "2024-01-15", "2024-01-21"},
+		{"leap february", &SummaryInput{Period: SummaryPeriodMonth, Date: "2024-02-10"}, "2024-02-01", "2024-02-29"},
+		{"custom", &SummaryInput{Period: SummaryPeriodCustom, StartDate: "2024-01-01", EndDate: "2024-03-31"}, "2024-01-01", "2024-03-31"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockDateRangeReader{}
+			uc, _ := NewSummaryUseCase(repo)
+			output, err := uc.Execute(context.Background(), tt.input)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if got := repo.LastStart.String(); got != tt.wantStart {
+				t.Errorf("start = %s, want %s", got, tt.wantStart)
+			}
+			if got := repo.LastEnd.String(); got != tt.wantEnd {
+				t.Errorf("end = %s, want %s", got, tt.wantEnd)
+			}
+			if output.StartDate != tt.wantStart || output.EndDate != tt.wantEnd {
+				t.Errorf("output range = %s..%s", output.StartDate, output.EndDate)
+			}
+		})
+	}
+}
+
+func TestSummaryUseCase_InvalidRange(t *testing.T) {
+	uc, _ := NewSummaryUseCase(&MockDateRangeReader{})
+	inputs := []*SummaryInput{
+		{Period: SummaryPeriodWeek, Date: "2024/01/17"},
+		{Period: SummaryPeriodCustom, StartDate: "2024-02-01", EndDate: "2024-01-01"},
+		{Period: SummaryPeriodCustom, StartDate: "2023-01-01", EndDate: "2024-12-31"},
+	}
+	for _, input := range inputs {
+		_, err := uc.Execute(context.Background(), input)
+		var ucErr *UseCaseError
+		if !errors.As(err, &ucErr) || ucErr.Code != ErrCodeInvalidInput {
+			t.Errorf("Execute(%+v) error = %v, want INVALID_INPUT", input, err)
+		}
+	}
+}
+
+func TestSummaryUseCase_Aggregates(t *testing.T) {
+	tokyo, _ := domain.NewLocation(35.6812, 139.7671, "Tokyo Station")
+	tokyoAgain, _ := domain.NewLocation(35.6813, 139.7670, "Tokyo Station")
+	unnamed, _ := domain.NewLocation(34.7025, 135.4959, "")
+	whisper, _ := domain.NewVoiceConfig(true, "whisper-1")
+	disabled, _ := domain.NewVoiceConfig(false, "whisper-1")
+
+	reports := []*domain.Nippou{
+		summaryFixture(t, "2024-01-17", "Price revision meeting", []string{"sales", "visit"}, tokyoAgain, whisper),
+		summaryFixture(t, "2024-01-15", "Kickoff", []string{"sales"}, tokyo, nil),
+		summaryFixture(t, "2024-01-15", "Follow-up call", nil, nil, disabled),
+		summaryFixture(t, "2024-01-20", "Weekend visit", []string{"商談"}, unnamed, whisper),
+	}
+	repo := &MockDateRangeReader{
+		FindByDateRangeFunc: func(startDate, endDate domain.CivilDate) ([]*domain.Nippou, error) {
+			return reports, nil
+		},
+	}
+	uc, _ := NewSummaryUseCase(repo)
+	uc.WithTimeFunc(func() time.Time { return time.Date(2024, 1, 19, 12, 0, 0, 0, time.UTC) })
+
+	output, err := uc.Execute(context.Background(), &SummaryInput{Period: SummaryPeriodWeek, Date: "2024-01-17"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if output.ReportCount != 4 || output.DaysReported != 3 {
+		t.Errorf("counts = %d reports / %d days", output.ReportCount, output.DaysReported)
+	}
+	// 16th, 18th and 19th are missing weekdays; 20th onwards is in the future
+	if strings.Join(output.MissingDates, ",") != "2024-01-16,2024-01-18,2024-01-19" || output.DaysWithoutReport != 3 {
+		t.Errorf("missing = %v (%d)", output.MissingDates, output.DaysWithoutReport)
+	}
+	wantTags := []TagFrequency{{"sales", 2}, {"visit", 1}, {"商談", 1}}
+	if len(output.TagFrequencies) != len(wantTags) {
+		t.Fatalf("tags = %v", output.TagFrequencies)
+	}
+	for i, want := range wantTags {
+		if output.TagFrequencies[i] != want {
+			t.Errorf("tags[%d] = %v, want %v", i, output.TagFrequencies[i], want)
+		}
+	}
+	if len(output.Locations) != 2 || output.Locations[0].Address != "Tokyo Station" || output.Locations[0].Count != 2 {
+		t.Errorf("locations = %+v", output.Locations)
+	}
+	if output.Voice.Reports != 2 || len(output.Voice.Models) != 1 || output.Voice.Models[0] != (ModelUsage{"whisper-1", 2}) {
+		t.Errorf("voice = %+v", output.Voice)
+	}
+	if output.Entries[0].Content != "Kickoff" || output.Entries[3].Date != "2024-01-20" {
+		t.Errorf("entries not in date order: %+v", output.Entries)
+	}
+
+	for _, want := range []string{
+		"# Weekly Summary: 2024-01-15 – 2024-01-21",
+		"- Reports: 4",
+		"- Days without a report: 3 (2024-01-16, 2024-01-18, 2024-01-19)",
+		"- Voice input: 2 reports (whisper-1 ×2)",
+		"| sales | 2 |",
+		"- Tokyo Station (2)",
+		"- 34.7025, 135.4959 (1)",
+		"### 2024-01-17 [sales, visit]\n\nPrice revision meeting",
+	} {
+		if !strings.Contains(output.Markdown, want) {
+			t.Errorf("Markdown missing %q:\n%s", want, output.Markdown)
+		}
+	}
+}
+
+func TestSummaryUseCase_Empty(t *testing.T) {
+	uc, _ := NewSummaryUseCase(&MockDateRangeReader{})
+	uc.WithTimeFunc(func() time.Time { return time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC) })
+
+	output, err := uc.Execute(context.Background(), &SummaryInput{Period: SummaryPeriodMonth, Date: "2024-02-01"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	// 21 weekdays minus 建国記念の日 (observed 2/12) and 天皇誕生日 (2/23)
+	if output.ReportCount != 0 || output.DaysWithoutReport != 19 {
+		t.Errorf("counts = %d reports / %d missing", output.ReportCount, output.DaysWithoutReport)
+	}
+	if output.TagFrequencies == nil || output.Locations == nil || output.Entries == nil || output.Voice.Models == nil {
+		t.Error("slices should be empty, not nil")
+	}
+}
+
+func TestSummaryUseCase_RepositoryError(t *testing.T) {
+	repo := &MockDateRangeReader{
+		FindByDateRangeFunc: func(startDate, endDate domain.CivilDate) ([]*domain.Nippou, error) {
+			return nil, errors.New("connection refused")
+		},
+	}
+	uc, _ := NewSummaryUseCase(repo)
+
+	_, err := uc.Execute(context.Background(), &SummaryInput{Period: SummaryPeriodWeek, Date: "2024-01-17"})
+	var ucErr *UseCaseError
+	if !errors.As(err, &ucErr) || ucErr.Code != ErrCodeRepositoryError {
+		t.Errorf("Execute() error = %v, want REPOSITORY_ERROR", err)
+	}
+}
+
+func TestSummaryUseCase_ContextCancelled(t *testing.T) {
+	uc, _ := NewSummaryUseCase(&MockDateRangeReader{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := uc.Execute(ctx, &SummaryInput{Period: SummaryPeriodWeek, Date: "2024-01-17"})
+	var ucErr *UseCaseError
+	if !errors.As(err, &ucErr) || ucErr.Code != ErrCodeContextCancelled {
+		t.Errorf("Execute() error = %v, want CONTEXT_CANCELLED", err)
+	}
+}